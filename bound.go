@@ -0,0 +1,77 @@
+package rrule
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrUnbounded is returned by WithUntilFromCount and WithCountFromUntil for a
+// rule with neither COUNT nor UNTIL, whose occurrences cannot be counted.
+var ErrUnbounded = errors.New("rrule: rule has neither COUNT nor UNTIL")
+
+// ErrNoBound is returned by WithUntilFromCount and WithCountFromUntil for a
+// bounded rule without occurrence, which no COUNT expresses and whose UNTIL would
+// be before DTSTART.
+var ErrNoBound = errors.New("rrule: rule without occurrence has no COUNT or UNTIL form")
+
+// WithUntilFromCount returns a copy of the rule ending with an UNTIL at its last
+// occurrence instead of a COUNT, for consumers that only accept UNTIL. The copy
+// generates the same occurrences. A rule already ending with an UNTIL is copied
+// as is.
+//
+// UNTIL is given in UTC, as RFC 5545 requires when DTSTART has a TZID, and is
+// written as a date-time like DTSTART.
+func (r *RRule) WithUntilFromCount() (*RRule, error) {
+	if r.count <= 0 {
+		if r.OrigOptions.Until.IsZero() {
+			return nil, ErrUnbounded
+		}
+		return r.withBound(0, r.OrigOptions.Until), nil
+	}
+
+	var last time.Time
+	next := r.Iterator()
+	for dt, ok := next(); ok; dt, ok = next() {
+		last = dt
+	}
+	if last.IsZero() {
+		return nil, ErrNoBound
+	}
+
+	return r.withBound(0, last), nil
+}
+
+// WithCountFromUntil returns a copy of the rule ending with the COUNT of its
+// occurrences instead of an UNTIL, for showing "N times". The copy generates the
+// same occurrences. A rule already ending with a COUNT is copied as is.
+func (r *RRule) WithCountFromUntil() (*RRule, error) {
+	if r.count > 0 {
+		return r.withBound(r.count, time.Time{}), nil
+	}
+	if r.OrigOptions.Until.IsZero() {
+		return nil, ErrUnbounded
+	}
+
+	count := r.Count()
+	if count <= 0 {
+		return nil, ErrNoBound
+	}
+
+	return r.withBound(count, time.Time{}), nil
+}
+
+// withBound returns a copy of the rule ending after count occurrences or at until.
+// DTSTART is kept, rather than defaulted to now again.
+func (r *RRule) withBound(count int, until time.Time) *RRule {
+	option := r.OrigOptions
+	option.Dtstart = r.dtstart
+	option.Count = count
+	option.Until = until
+	if !until.IsZero() {
+		option.Until = until.UTC()
+	}
+
+	rule := buildRRule(option)
+	rule.i18n = r.i18n
+	return &rule
+}
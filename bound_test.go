@@ -0,0 +1,121 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestWithUntilFromCount(t *testing.T) {
+	var tests = []struct {
+		rule     string
+		expected string
+	}{
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=TU,TH",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;UNTIL=19971002T090000Z;BYDAY=TU,TH",
+		},
+		{
+			"DTSTART;TZID=America/New_York:19971020T090000\nRRULE:FREQ=DAILY;COUNT=14",
+			"DTSTART;TZID=America/New_York:19971020T090000\nRRULE:FREQ=DAILY;UNTIL=19971102T140000Z",
+		},
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;UNTIL=19970905T090000Z",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;UNTIL=19970905T090000Z",
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		got, err := r.WithUntilFromCount()
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.rule, err)
+		}
+		if got.String() != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.rule, tt.expected, got.String())
+		}
+		if !got.OrigOptions.Until.IsZero() && got.OrigOptions.Until.Location() != time.UTC {
+			t.Errorf("%q: expected UNTIL in UTC, got %v", tt.rule, got.OrigOptions.Until)
+		}
+		if !timesEqual(got.All(), r.All()) {
+			t.Errorf("%q: expected %v, got %v", tt.rule, r.All(), got.All())
+		}
+	}
+}
+
+func TestWithCountFromUntil(t *testing.T) {
+	var tests = []struct {
+		rule     string
+		expected string
+	}{
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;UNTIL=19971007T000000Z;BYDAY=TU,TH",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=TU,TH",
+		},
+		{
+			"DTSTART;TZID=America/New_York:19971020T090000\nRRULE:FREQ=DAILY;UNTIL=19971102T140000Z",
+			"DTSTART;TZID=America/New_York:19971020T090000\nRRULE:FREQ=DAILY;COUNT=14",
+		},
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;COUNT=4",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;COUNT=4",
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		got, err := r.WithCountFromUntil()
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.rule, err)
+		}
+		if got.String() != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.rule, tt.expected, got.String())
+		}
+		if !timesEqual(got.All(), r.All()) {
+			t.Errorf("%q: expected %v, got %v", tt.rule, r.All(), got.All())
+		}
+	}
+}
+
+func TestWithBoundErrors(t *testing.T) {
+	infinite, _ := StrToRRule("DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY")
+	if _, err := infinite.WithUntilFromCount(); !errors.Is(err, ErrUnbounded) {
+		t.Errorf("expected ErrUnbounded, got %v", err)
+	}
+	if _, err := infinite.WithCountFromUntil(); !errors.Is(err, ErrUnbounded) {
+		t.Errorf("expected ErrUnbounded, got %v", err)
+	}
+
+	empty, _ := StrToRRule("DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;UNTIL=20100101T000000Z;BYMONTH=2;BYMONTHDAY=30")
+	if _, err := empty.WithCountFromUntil(); !errors.Is(err, ErrNoBound) {
+		t.Errorf("expected ErrNoBound, got %v", err)
+	}
+	empty, _ = StrToRRule("DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;COUNT=2;BYMONTH=2;BYMONTHDAY=30")
+	if _, err := empty.WithUntilFromCount(); !errors.Is(err, ErrNoBound) {
+		t.Errorf("expected ErrNoBound, got %v", err)
+	}
+}
+
+func TestWithBoundKeepsDTStart(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: SECONDLY, Count: 3})
+	time.Sleep(time.Second)
+
+	got, err := r.WithUntilFromCount()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !got.GetDTStart().Equal(r.GetDTStart()) {
+		t.Errorf("expected DTSTART %v, got %v", r.GetDTStart(), got.GetDTStart())
+	}
+	if !timesEqual(got.All(), r.All()) {
+		t.Errorf("expected %v, got %v", r.All(), got.All())
+	}
+}
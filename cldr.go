@@ -0,0 +1,325 @@
+package rrule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// cldrLocale holds the gregorian calendar data of a language, taken from the
+// Unicode CLDR. golang.org/x/text does not export calendar names, so only the
+// ordinal plural rules come from golang.org/x/text/feature/plural.
+type cldrLocale struct {
+	// months are the stand-alone wide month names.
+	months [12]string
+	// dateMonths are the wide month names used inside dates, when they differ from months.
+	dateMonths [12]string
+	// weekdays are the stand-alone wide weekday names, Monday first.
+	weekdays [7]string
+	// shortMonths and shortWeekdays are the abbreviated names, e.g. "Sep" and "Mon".
+	shortMonths   [12]string
+	shortWeekdays [7]string
+	// dateLong is the long date pattern, e.g. "MMMM d, y".
+	dateLong string
+	// dateMedium is the medium date pattern, e.g. "MMM d, y".
+	dateMedium string
+	// timeShort and timeMedium are the time patterns without and with seconds.
+	timeShort  string
+	timeMedium string
+	// dayPeriods are the AM and PM markers.
+	dayPeriods [2]string
+	// ordinal renders numeric ordinals by their CLDR ordinal plural category.
+	ordinal cldrOrdinal
+	// last names the last weekday or day, e.g. "last".
+	last string
+	// nthLast formats an ordinal counted from the end from the ordinal (%[1]s),
+	// last (%[2]s) and the number (%[3]d), e.g. "2nd last".
+	nthLast string
+	// nthWeekday formats an nth weekday from the ordinal (%[1]s) and the weekday (%[2]s).
+	nthWeekday string
+	// firstDay replaces the day 1 in dates, e.g. "1er" in French.
+	firstDay string
+	// cases holds the forms the built-in translations request with the In method
+	// of a slot, by grammatical case.
+	cases map[string]*cldrCase
+}
+
+// cldrCase holds the names and ordinals of a grammatical case, e.g. the Portuguese
+// weekdays with their contracted article. Empty names and patterns fall back to
+// the uninflected ones.
+type cldrCase struct {
+	// article prefixes the names and ordinals, e.g. "le ".
+	article string
+	months  [12]string
+	// weekdays, nthWeekdays, lastWeekdays and nthLastWeekdays are the weekdays
+	// without ordinal, with one, with last and counted from the end, Monday first.
+	// The patterns format the number (%[1]s).
+	weekdays        [7]string
+	nthWeekdays     [7]string
+	lastWeekdays    [7]string
+	nthLastWeekdays [7]string
+	// nth, last and nthLast are the ordinals, counted from the start, last and
+	// counted from the end. The patterns format the number (%[1]s) as the day of
+	// a date, e.g. "1er" in French.
+	nth, last, nthLast string
+}
+
+type cldrOrdinal struct {
+	prefix string
+	// suffixes by ordinal plural category; plural.Other is the default.
+	suffixes map[plural.Form]string
+}
+
+// cldrFormatter is a TimeFormatter rendering the CLDR data of a language.
+type cldrFormatter struct {
+	lang   language.Tag
+	locale *cldrLocale
+}
+
+var (
+	_ TimeFormatter         = cldrFormatter{}
+	_ ClockFormatter        = cldrFormatter{}
+	_ AbbreviatingFormatter = cldrFormatter{}
+	_ InflectingFormatter   = cldrFormatter{}
+)
+
+// NewTimeFormatter returns a TimeFormatter deriving month names, weekday names,
+// ordinals and date and time formats from CLDR data for the language closest to lang.
+// The data of this package covers English, Indonesian, German, French, Spanish,
+// Portuguese, Italian, Dutch, Swedish, Danish, Norwegian, Finnish, Polish, Czech,
+// Russian, Ukrainian, Turkish, Japanese, Chinese and Korean; other languages fall
+// back to English.
+func NewTimeFormatter(lang language.Tag) TimeFormatter {
+	_, i, confidence := cldrMatcher.Match(lang)
+	if confidence == language.No {
+		i = 0
+	}
+
+	tag := cldrTags[i]
+	return cldrFormatter{
+		lang:   tag,
+		locale: cldrLocales[tag],
+	}
+}
+
+// Format implements TimeFormatter.
+func (f cldrFormatter) Format(t time.Time) string {
+	return f.pattern(f.locale.dateLong, t.Year(), int(t.Month()), t.Day(), 0, 0, 0)
+}
+
+// MonthName implements TimeFormatter.
+func (f cldrFormatter) MonthName(i int) string {
+	return f.locale.months[i-1]
+}
+
+// Nth implements TimeFormatter.
+func (f cldrFormatter) Nth(i int) string {
+	if i == -1 {
+		return f.locale.last
+	}
+
+	npos := abs(i)
+	form := plural.Ordinal.MatchPlural(f.lang, npos, 0, 0, 0, 0)
+	suffix, ok := f.locale.ordinal.suffixes[form]
+	if !ok {
+		suffix = f.locale.ordinal.suffixes[plural.Other]
+	}
+
+	nth := f.locale.ordinal.prefix + strconv.Itoa(npos) + suffix
+	if i < 0 {
+		return fmt.Sprintf(f.locale.nthLast, nth, f.locale.last, npos)
+	}
+
+	return nth
+}
+
+// WeekDayName implements TimeFormatter.
+func (f cldrFormatter) WeekDayName(w Weekday) string {
+	weekday := f.locale.weekdays[w.Day()]
+	if n := w.N(); n != 0 {
+		return fmt.Sprintf(f.locale.nthWeekday, f.Nth(n), weekday)
+	}
+
+	return weekday
+}
+
+// MonthNameIn implements InflectingFormatter.
+func (f cldrFormatter) MonthNameIn(month int, grammaticalCase string) string {
+	c, ok := f.locale.cases[grammaticalCase]
+	if !ok {
+		return f.MonthName(month)
+	}
+	if c.months[month-1] == "" {
+		return c.article + f.MonthName(month)
+	}
+
+	return c.article + c.months[month-1]
+}
+
+// NthIn implements InflectingFormatter.
+func (f cldrFormatter) NthIn(i int, grammaticalCase string) string {
+	c, ok := f.locale.cases[grammaticalCase]
+	if !ok {
+		return f.Nth(i)
+	}
+
+	pattern := c.nth
+	switch {
+	case i == -1:
+		pattern = c.last
+	case i < 0:
+		pattern = c.nthLast
+	}
+	if pattern == "" {
+		return c.article + f.Nth(i)
+	}
+
+	day := strconv.Itoa(abs(i))
+	if abs(i) == 1 && f.locale.firstDay != "" {
+		day = f.locale.firstDay
+	}
+
+	return c.article + inflect(pattern, day)
+}
+
+// WeekDayNameIn implements InflectingFormatter.
+func (f cldrFormatter) WeekDayNameIn(w Weekday, grammaticalCase string) string {
+	c, ok := f.locale.cases[grammaticalCase]
+	if !ok {
+		return f.WeekDayName(w)
+	}
+
+	n := w.N()
+	pattern := c.nthWeekdays[w.Day()]
+	switch {
+	case n == 0:
+		pattern = c.weekdays[w.Day()]
+	case n == -1:
+		pattern = c.lastWeekdays[w.Day()]
+	case n < 0:
+		pattern = c.nthLastWeekdays[w.Day()]
+	}
+	if pattern == "" {
+		return c.article + f.WeekDayName(w)
+	}
+
+	return c.article + inflect(pattern, strconv.Itoa(abs(n)))
+}
+
+// inflect formats pattern with n when it has a verb for it.
+func inflect(pattern, n string) string {
+	if !strings.Contains(pattern, "%") {
+		return pattern
+	}
+
+	return fmt.Sprintf(pattern, n)
+}
+
+// ShortFormat implements AbbreviatingFormatter.
+func (f cldrFormatter) ShortFormat(t time.Time) string {
+	return f.pattern(f.locale.dateMedium, t.Year(), int(t.Month()), t.Day(), 0, 0, 0)
+}
+
+// ShortMonthName implements AbbreviatingFormatter.
+func (f cldrFormatter) ShortMonthName(i int) string {
+	return f.locale.shortMonths[i-1]
+}
+
+// ShortWeekDayName implements AbbreviatingFormatter.
+func (f cldrFormatter) ShortWeekDayName(w Weekday) string {
+	weekday := f.locale.shortWeekdays[w.Day()]
+	if n := w.N(); n != 0 {
+		return fmt.Sprintf(f.locale.nthWeekday, f.Nth(n), weekday)
+	}
+
+	return weekday
+}
+
+// Clock implements ClockFormatter.
+func (f cldrFormatter) Clock(hour, minute, second int) string {
+	if second != 0 {
+		return f.pattern(f.locale.timeMedium, 0, 0, 0, hour, minute, second)
+	}
+
+	return f.pattern(f.locale.timeShort, 0, 0, 0, hour, minute, second)
+}
+
+// pattern renders a CLDR date or time pattern. Only the fields used by the
+// patterns of cldrLocales are supported.
+func (f cldrFormatter) pattern(pattern string, year, month, day, hour, minute, second int) string {
+	var sb strings.Builder
+	for i := 0; i < len(pattern); {
+		c := pattern[i]
+		if c == '\'' {
+			end := strings.IndexByte(pattern[i+1:], '\'')
+			if end < 0 {
+				end = len(pattern) - i - 1
+			}
+			sb.WriteString(pattern[i+1 : i+1+end])
+			i += end + 2
+			continue
+		}
+
+		if !('a' <= c && c <= 'z' || 'A' <= c && c <= 'Z') {
+			sb.WriteByte(c)
+			i++
+			continue
+		}
+
+		n := 1
+		for i+n < len(pattern) && pattern[i+n] == c {
+			n++
+		}
+		i += n
+
+		switch c {
+		case 'y':
+			sb.WriteString(strconv.Itoa(year))
+		case 'M', 'L':
+			switch {
+			case n >= 4:
+				sb.WriteString(f.dateMonth(month))
+			case n == 3:
+				sb.WriteString(f.locale.shortMonths[month-1])
+			case n == 2:
+				fmt.Fprintf(&sb, "%02d", month)
+			default:
+				sb.WriteString(strconv.Itoa(month))
+			}
+		case 'd':
+			if day == 1 && f.locale.firstDay != "" {
+				sb.WriteString(f.locale.firstDay)
+				break
+			}
+			fmt.Fprintf(&sb, "%0*d", n, day)
+		case 'H':
+			fmt.Fprintf(&sb, "%0*d", n, hour)
+		case 'h':
+			hour12 := hour % 12
+			if hour12 == 0 {
+				hour12 = 12
+			}
+			fmt.Fprintf(&sb, "%0*d", n, hour12)
+		case 'm':
+			fmt.Fprintf(&sb, "%0*d", n, minute)
+		case 's':
+			fmt.Fprintf(&sb, "%0*d", n, second)
+		case 'a':
+			sb.WriteString(f.locale.dayPeriods[hour/12])
+		}
+	}
+
+	return sb.String()
+}
+
+func (f cldrFormatter) dateMonth(month int) string {
+	if name := f.locale.dateMonths[month-1]; name != "" {
+		return name
+	}
+
+	return f.locale.months[month-1]
+}
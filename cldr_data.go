@@ -0,0 +1,461 @@
+package rrule
+
+import (
+	"golang.org/x/text/feature/plural"
+	"golang.org/x/text/language"
+)
+
+// cldrTags lists the languages with CLDR data, English first as the fallback.
+var cldrTags = []language.Tag{
+	language.English,
+	language.Indonesian,
+	language.German,
+	language.French,
+	language.Spanish,
+	language.Portuguese,
+	language.Italian,
+	language.Dutch,
+	language.Swedish,
+	language.Danish,
+	language.Norwegian,
+	language.Finnish,
+	language.Polish,
+	language.Czech,
+	language.Russian,
+	language.Ukrainian,
+	language.Turkish,
+	language.Japanese,
+	language.Chinese,
+	language.Korean,
+}
+
+var cldrMatcher = language.NewMatcher(cldrTags)
+
+// cldrLocales holds the CLDR gregorian calendar data of cldrTags.
+var cldrLocales = map[language.Tag]*cldrLocale{
+	language.English: {
+		months:        [12]string{"January", "February", "March", "April", "May", "June", "July", "August", "September", "October", "November", "December"},
+		weekdays:      [7]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"},
+		shortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "May", "Jun", "Jul", "Aug", "Sep", "Oct", "Nov", "Dec"},
+		shortWeekdays: [7]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"},
+		dateLong:      "MMMM d, y",
+		dateMedium:    "MMM d, y",
+		timeShort:     "h:mm a",
+		timeMedium:    "h:mm:ss a",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.One: "st", plural.Two: "nd", plural.Few: "rd", plural.Other: "th"},
+		},
+		last:       "last",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Indonesian: {
+		months:        [12]string{"Januari", "Februari", "Maret", "April", "Mei", "Juni", "Juli", "Agustus", "September", "Oktober", "November", "Desember"},
+		weekdays:      [7]string{"Senin", "Selasa", "Rabu", "Kamis", "Jumat", "Sabtu", "Minggu"},
+		shortMonths:   [12]string{"Jan", "Feb", "Mar", "Apr", "Mei", "Jun", "Jul", "Agu", "Sep", "Okt", "Nov", "Des"},
+		shortWeekdays: [7]string{"Sen", "Sel", "Rab", "Kam", "Jum", "Sab", "Min"},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH.mm",
+		timeMedium:    "HH.mm.ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			prefix:   "ke-",
+			suffixes: map[plural.Form]string{plural.Other: ""},
+		},
+		last:       "terakhir",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[2]s %[1]s",
+	},
+	language.German: {
+		months:        [12]string{"Januar", "Februar", "März", "April", "Mai", "Juni", "Juli", "August", "September", "Oktober", "November", "Dezember"},
+		weekdays:      [7]string{"Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag", "Sonntag"},
+		shortMonths:   [12]string{"Jan.", "Feb.", "März", "Apr.", "Mai", "Juni", "Juli", "Aug.", "Sept.", "Okt.", "Nov.", "Dez."},
+		shortWeekdays: [7]string{"Mo.", "Di.", "Mi.", "Do.", "Fr.", "Sa.", "So."},
+		dateLong:      "d. MMMM y",
+		dateMedium:    "dd.MM.y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "letzten",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+		cases: map[string]*cldrCase{
+			// "day" is a day of the month, of which only the last one differs.
+			"day": {
+				last: "letzten Tag",
+			},
+		},
+	},
+	language.French: {
+		months:        [12]string{"janvier", "février", "mars", "avril", "mai", "juin", "juillet", "août", "septembre", "octobre", "novembre", "décembre"},
+		weekdays:      [7]string{"lundi", "mardi", "mercredi", "jeudi", "vendredi", "samedi", "dimanche"},
+		shortMonths:   [12]string{"janv.", "févr.", "mars", "avr.", "mai", "juin", "juil.", "août", "sept.", "oct.", "nov.", "déc."},
+		shortWeekdays: [7]string{"lun.", "mar.", "mer.", "jeu.", "ven.", "sam.", "dim."},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.One: "er", plural.Other: "e"},
+		},
+		last:       "dernier",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+		firstDay:   "1er",
+		cases: map[string]*cldrCase{
+			// "on" and "day" repeat the article of each weekday and day of the
+			// month, e.g. "le 2e mardi et le dernier vendredi" and "le 1er et le 15".
+			"on": {
+				article: "le ",
+			},
+			"day": {
+				article: "le ",
+				nth:     "%[1]s",
+				last:    "dernier jour",
+			},
+		},
+	},
+	language.Spanish: {
+		months:        [12]string{"enero", "febrero", "marzo", "abril", "mayo", "junio", "julio", "agosto", "septiembre", "octubre", "noviembre", "diciembre"},
+		weekdays:      [7]string{"lunes", "martes", "miércoles", "jueves", "viernes", "sábado", "domingo"},
+		shortMonths:   [12]string{"ene", "feb", "mar", "abr", "may", "jun", "jul", "ago", "sept", "oct", "nov", "dic"},
+		shortWeekdays: [7]string{"lun", "mar", "mié", "jue", "vie", "sáb", "dom"},
+		dateLong:      "d 'de' MMMM 'de' y",
+		dateMedium:    "d MMM y",
+		timeShort:     "H:mm",
+		timeMedium:    "H:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "º"},
+		},
+		last:       "último",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+		cases: map[string]*cldrCase{
+			// "on" and "day" repeat the article of each weekday and day of the
+			// month, e.g. "el 2º martes y el último viernes" and "el 1 y el 15".
+			"on": {
+				article: "el ",
+			},
+			"day": {
+				article: "el ",
+				nth:     "%[1]s",
+				last:    "último día",
+			},
+		},
+	},
+	language.Portuguese: {
+		months:        [12]string{"janeiro", "fevereiro", "março", "abril", "maio", "junho", "julho", "agosto", "setembro", "outubro", "novembro", "dezembro"},
+		weekdays:      [7]string{"segunda-feira", "terça-feira", "quarta-feira", "quinta-feira", "sexta-feira", "sábado", "domingo"},
+		shortMonths:   [12]string{"jan.", "fev.", "mar.", "abr.", "mai.", "jun.", "jul.", "ago.", "set.", "out.", "nov.", "dez."},
+		shortWeekdays: [7]string{"seg.", "ter.", "qua.", "qui.", "sex.", "sáb.", "dom."},
+		dateLong:      "d 'de' MMMM 'de' y",
+		dateMedium:    "d 'de' MMM 'de' y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "º"},
+		},
+		last:       "último",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+		cases: map[string]*cldrCase{
+			// "on" has the contracted article agreeing with the weekday, e.g.
+			// "às segundas-feiras", "na 2ª terça-feira" and "no último sábado".
+			"on": {
+				weekdays:        [7]string{"às segundas-feiras", "às terças-feiras", "às quartas-feiras", "às quintas-feiras", "às sextas-feiras", "aos sábados", "aos domingos"},
+				nthWeekdays:     [7]string{"na %[1]sª segunda-feira", "na %[1]sª terça-feira", "na %[1]sª quarta-feira", "na %[1]sª quinta-feira", "na %[1]sª sexta-feira", "no %[1]sº sábado", "no %[1]sº domingo"},
+				lastWeekdays:    [7]string{"na última segunda-feira", "na última terça-feira", "na última quarta-feira", "na última quinta-feira", "na última sexta-feira", "no último sábado", "no último domingo"},
+				nthLastWeekdays: [7]string{"na %[1]sª segunda-feira a contar do fim", "na %[1]sª terça-feira a contar do fim", "na %[1]sª quarta-feira a contar do fim", "na %[1]sª quinta-feira a contar do fim", "na %[1]sª sexta-feira a contar do fim", "no %[1]sº sábado a contar do fim", "no %[1]sº domingo a contar do fim"},
+				nth:             "no dia %[1]s",
+				last:            "no último dia",
+				nthLast:         "no %[1]sº dia a contar do fim",
+			},
+		},
+	},
+	language.Italian: {
+		months:        [12]string{"gennaio", "febbraio", "marzo", "aprile", "maggio", "giugno", "luglio", "agosto", "settembre", "ottobre", "novembre", "dicembre"},
+		weekdays:      [7]string{"lunedì", "martedì", "mercoledì", "giovedì", "venerdì", "sabato", "domenica"},
+		shortMonths:   [12]string{"gen", "feb", "mar", "apr", "mag", "giu", "lug", "ago", "set", "ott", "nov", "dic"},
+		shortWeekdays: [7]string{"lun", "mar", "mer", "gio", "ven", "sab", "dom"},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "º"},
+		},
+		last:       "ultimo",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Dutch: {
+		months:        [12]string{"januari", "februari", "maart", "april", "mei", "juni", "juli", "augustus", "september", "oktober", "november", "december"},
+		weekdays:      [7]string{"maandag", "dinsdag", "woensdag", "donderdag", "vrijdag", "zaterdag", "zondag"},
+		shortMonths:   [12]string{"jan", "feb", "mrt", "apr", "mei", "jun", "jul", "aug", "sep", "okt", "nov", "dec"},
+		shortWeekdays: [7]string{"ma", "di", "wo", "do", "vr", "za", "zo"},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "e"},
+		},
+		last:       "laatste",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+		cases: map[string]*cldrCase{
+			// "on" repeats the article of each weekday, e.g. "de 2e dinsdag en de
+			// laatste vrijdag", and "day" is a day of the month, of which only the
+			// last one differs.
+			"on": {
+				article: "de ",
+			},
+			"day": {
+				last: "laatste dag",
+			},
+		},
+	},
+	language.Swedish: {
+		months:        [12]string{"januari", "februari", "mars", "april", "maj", "juni", "juli", "augusti", "september", "oktober", "november", "december"},
+		weekdays:      [7]string{"måndag", "tisdag", "onsdag", "torsdag", "fredag", "lördag", "söndag"},
+		shortMonths:   [12]string{"jan.", "feb.", "mars", "apr.", "maj", "juni", "juli", "aug.", "sep.", "okt.", "nov.", "dec."},
+		shortWeekdays: [7]string{"mån", "tis", "ons", "tors", "fre", "lör", "sön"},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.One: ":a", plural.Other: ":e"},
+		},
+		last:       "sista",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Danish: {
+		months:        [12]string{"januar", "februar", "marts", "april", "maj", "juni", "juli", "august", "september", "oktober", "november", "december"},
+		weekdays:      [7]string{"mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag", "søndag"},
+		shortMonths:   [12]string{"jan.", "feb.", "mar.", "apr.", "maj", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "dec."},
+		shortWeekdays: [7]string{"man.", "tirs.", "ons.", "tors.", "fre.", "lør.", "søn."},
+		dateLong:      "d. MMMM y",
+		dateMedium:    "d. MMM y",
+		timeShort:     "HH.mm",
+		timeMedium:    "HH.mm.ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "sidste",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Norwegian: {
+		months:        [12]string{"januar", "februar", "mars", "april", "mai", "juni", "juli", "august", "september", "oktober", "november", "desember"},
+		weekdays:      [7]string{"mandag", "tirsdag", "onsdag", "torsdag", "fredag", "lørdag", "søndag"},
+		shortMonths:   [12]string{"jan.", "feb.", "mar.", "apr.", "mai", "jun.", "jul.", "aug.", "sep.", "okt.", "nov.", "des."},
+		shortWeekdays: [7]string{"man.", "tir.", "ons.", "tor.", "fre.", "lør.", "søn."},
+		dateLong:      "d. MMMM y",
+		dateMedium:    "d. MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "siste",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Finnish: {
+		months:        [12]string{"tammikuu", "helmikuu", "maaliskuu", "huhtikuu", "toukokuu", "kesäkuu", "heinäkuu", "elokuu", "syyskuu", "lokakuu", "marraskuu", "joulukuu"},
+		dateMonths:    [12]string{"tammikuuta", "helmikuuta", "maaliskuuta", "huhtikuuta", "toukokuuta", "kesäkuuta", "heinäkuuta", "elokuuta", "syyskuuta", "lokakuuta", "marraskuuta", "joulukuuta"},
+		weekdays:      [7]string{"maanantai", "tiistai", "keskiviikko", "torstai", "perjantai", "lauantai", "sunnuntai"},
+		shortMonths:   [12]string{"tammik.", "helmik.", "maalisk.", "huhtik.", "toukok.", "kesäk.", "heinäk.", "elok.", "syysk.", "lokak.", "marrask.", "jouluk."},
+		shortWeekdays: [7]string{"ma", "ti", "ke", "to", "pe", "la", "su"},
+		dateLong:      "d. MMMM y",
+		dateMedium:    "d.M.y",
+		timeShort:     "H.mm",
+		timeMedium:    "H.mm.ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "viimeinen",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Polish: {
+		months:        [12]string{"styczeń", "luty", "marzec", "kwiecień", "maj", "czerwiec", "lipiec", "sierpień", "wrzesień", "październik", "listopad", "grudzień"},
+		dateMonths:    [12]string{"stycznia", "lutego", "marca", "kwietnia", "maja", "czerwca", "lipca", "sierpnia", "września", "października", "listopada", "grudnia"},
+		weekdays:      [7]string{"poniedziałek", "wtorek", "środa", "czwartek", "piątek", "sobota", "niedziela"},
+		shortMonths:   [12]string{"sty", "lut", "mar", "kwi", "maj", "cze", "lip", "sie", "wrz", "paź", "lis", "gru"},
+		shortWeekdays: [7]string{"pon.", "wt.", "śr.", "czw.", "pt.", "sob.", "niedz."},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "ostatni",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Czech: {
+		months:        [12]string{"leden", "únor", "březen", "duben", "květen", "červen", "červenec", "srpen", "září", "říjen", "listopad", "prosinec"},
+		dateMonths:    [12]string{"ledna", "února", "března", "dubna", "května", "června", "července", "srpna", "září", "října", "listopadu", "prosince"},
+		weekdays:      [7]string{"pondělí", "úterý", "středa", "čtvrtek", "pátek", "sobota", "neděle"},
+		shortMonths:   [12]string{"led", "úno", "bře", "dub", "kvě", "čvn", "čvc", "srp", "zář", "říj", "lis", "pro"},
+		shortWeekdays: [7]string{"po", "út", "st", "čt", "pá", "so", "ne"},
+		dateLong:      "d. MMMM y",
+		dateMedium:    "d. M. y",
+		timeShort:     "H:mm",
+		timeMedium:    "H:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "poslední",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Russian: {
+		months:        [12]string{"январь", "февраль", "март", "апрель", "май", "июнь", "июль", "август", "сентябрь", "октябрь", "ноябрь", "декабрь"},
+		dateMonths:    [12]string{"января", "февраля", "марта", "апреля", "мая", "июня", "июля", "августа", "сентября", "октября", "ноября", "декабря"},
+		weekdays:      [7]string{"понедельник", "вторник", "среда", "четверг", "пятница", "суббота", "воскресенье"},
+		shortMonths:   [12]string{"янв.", "февр.", "мар.", "апр.", "мая", "июн.", "июл.", "авг.", "сент.", "окт.", "нояб.", "дек."},
+		shortWeekdays: [7]string{"пн", "вт", "ср", "чт", "пт", "сб", "вс"},
+		dateLong:      "d MMMM y 'г'.",
+		dateMedium:    "d MMM y 'г'.",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "-й"},
+		},
+		last:       "последний",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Ukrainian: {
+		months:        [12]string{"січень", "лютий", "березень", "квітень", "травень", "червень", "липень", "серпень", "вересень", "жовтень", "листопад", "грудень"},
+		dateMonths:    [12]string{"січня", "лютого", "березня", "квітня", "травня", "червня", "липня", "серпня", "вересня", "жовтня", "листопада", "грудня"},
+		weekdays:      [7]string{"понеділок", "вівторок", "середа", "четвер", "пʼятниця", "субота", "неділя"},
+		shortMonths:   [12]string{"січ.", "лют.", "бер.", "квіт.", "трав.", "черв.", "лип.", "серп.", "вер.", "жовт.", "лист.", "груд."},
+		shortWeekdays: [7]string{"пн", "вт", "ср", "чт", "пт", "сб", "нд"},
+		dateLong:      "d MMMM y 'р'.",
+		dateMedium:    "d MMM y 'р'.",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "-й"},
+		},
+		last:       "останній",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Turkish: {
+		months:        [12]string{"Ocak", "Şubat", "Mart", "Nisan", "Mayıs", "Haziran", "Temmuz", "Ağustos", "Eylül", "Ekim", "Kasım", "Aralık"},
+		weekdays:      [7]string{"Pazartesi", "Salı", "Çarşamba", "Perşembe", "Cuma", "Cumartesi", "Pazar"},
+		shortMonths:   [12]string{"Oca", "Şub", "Mar", "Nis", "May", "Haz", "Tem", "Ağu", "Eyl", "Eki", "Kas", "Ara"},
+		shortWeekdays: [7]string{"Pzt", "Sal", "Çar", "Per", "Cum", "Cmt", "Paz"},
+		dateLong:      "d MMMM y",
+		dateMedium:    "d MMM y",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"AM", "PM"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "."},
+		},
+		last:       "son",
+		nthLast:    "%[1]s %[2]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+	language.Japanese: {
+		months:        [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		weekdays:      [7]string{"月曜日", "火曜日", "水曜日", "木曜日", "金曜日", "土曜日", "日曜日"},
+		shortMonths:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		shortWeekdays: [7]string{"月", "火", "水", "木", "金", "土", "日"},
+		dateLong:      "y年M月d日",
+		dateMedium:    "y/MM/dd",
+		timeShort:     "H:mm",
+		timeMedium:    "H:mm:ss",
+		dayPeriods:    [2]string{"午前", "午後"},
+		ordinal: cldrOrdinal{
+			prefix:   "第",
+			suffixes: map[plural.Form]string{plural.Other: ""},
+		},
+		last:       "最終",
+		nthLast:    "最後から%[3]d番目の",
+		nthWeekday: "%[1]s%[2]s",
+		cases: map[string]*cldrCase{
+			// "day" and "yearday" are days of the month and of the year, e.g. "15日"
+			// and "100日目".
+			"day": {
+				nth:     "%[1]s日",
+				last:    "末日",
+				nthLast: "最後から%[1]s番目の日",
+			},
+			"yearday": {
+				nth:     "%[1]s日目",
+				last:    "最終日",
+				nthLast: "最後から%[1]s番目の日",
+			},
+		},
+	},
+	language.Chinese: {
+		months:        [12]string{"一月", "二月", "三月", "四月", "五月", "六月", "七月", "八月", "九月", "十月", "十一月", "十二月"},
+		weekdays:      [7]string{"星期一", "星期二", "星期三", "星期四", "星期五", "星期六", "星期日"},
+		shortMonths:   [12]string{"1月", "2月", "3月", "4月", "5月", "6月", "7月", "8月", "9月", "10月", "11月", "12月"},
+		shortWeekdays: [7]string{"周一", "周二", "周三", "周四", "周五", "周六", "周日"},
+		dateLong:      "y年M月d日",
+		dateMedium:    "y年M月d日",
+		timeShort:     "HH:mm",
+		timeMedium:    "HH:mm:ss",
+		dayPeriods:    [2]string{"上午", "下午"},
+		ordinal: cldrOrdinal{
+			prefix:   "第",
+			suffixes: map[plural.Form]string{plural.Other: ""},
+		},
+		last:       "最后一",
+		nthLast:    "倒数%[1]s",
+		nthWeekday: "%[1]s个%[2]s",
+		cases: map[string]*cldrCase{
+			// "day" is a day of the month, e.g. "15日" and "最后一天".
+			"day": {
+				nth:     "%[1]s日",
+				last:    "最后一天",
+				nthLast: "倒数第%[1]s天",
+			},
+		},
+	},
+	language.Korean: {
+		months:        [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		weekdays:      [7]string{"월요일", "화요일", "수요일", "목요일", "금요일", "토요일", "일요일"},
+		shortMonths:   [12]string{"1월", "2월", "3월", "4월", "5월", "6월", "7월", "8월", "9월", "10월", "11월", "12월"},
+		shortWeekdays: [7]string{"월", "화", "수", "목", "금", "토", "일"},
+		dateLong:      "y년 M월 d일",
+		dateMedium:    "y. M. d.",
+		timeShort:     "a h:mm",
+		timeMedium:    "a h:mm:ss",
+		dayPeriods:    [2]string{"오전", "오후"},
+		ordinal: cldrOrdinal{
+			suffixes: map[plural.Form]string{plural.Other: "번째"},
+		},
+		last:       "마지막",
+		nthLast:    "뒤에서 %[1]s",
+		nthWeekday: "%[1]s %[2]s",
+	},
+}
@@ -0,0 +1,52 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestNewTimeFormatter(t *testing.T) {
+	date := time.Date(2007, time.January, 1, 17, 30, 15, 0, time.UTC)
+
+	var tests = []struct {
+		lang     language.Tag
+		got      func(f TimeFormatter) string
+		expected string
+	}{
+		{language.English, func(f TimeFormatter) string { return f.Nth(1) }, "1st"},
+		{language.English, func(f TimeFormatter) string { return f.Nth(11) }, "11th"},
+		{language.English, func(f TimeFormatter) string { return f.Nth(22) }, "22nd"},
+		{language.English, func(f TimeFormatter) string { return f.Nth(-3) }, "3rd last"},
+		{language.English, func(f TimeFormatter) string { return f.Nth(-1) }, "last"},
+		{language.English, func(f TimeFormatter) string { return f.Format(date) }, "January 1, 2007"},
+		{language.English, func(f TimeFormatter) string { return f.(ClockFormatter).Clock(17, 30, 15) }, "5:30:15 PM"},
+		{language.Swahili, func(f TimeFormatter) string { return f.MonthName(3) }, "March"},
+		{language.French, func(f TimeFormatter) string { return f.Nth(1) }, "1er"},
+		{language.French, func(f TimeFormatter) string { return f.Nth(2) }, "2e"},
+		{language.French, func(f TimeFormatter) string { return f.WeekDayName(FR.Nth(-2)) }, "2e dernier vendredi"},
+		{language.Swedish, func(f TimeFormatter) string { return f.Nth(2) }, "2:a"},
+		{language.Swedish, func(f TimeFormatter) string { return f.Nth(12) }, "12:e"},
+		{language.MustParse("pt-BR"), func(f TimeFormatter) string { return f.Format(date) }, "1 de janeiro de 2007"},
+		{language.German, func(f TimeFormatter) string { return f.(ClockFormatter).Clock(9, 5, 0) }, "09:05"},
+		{language.Finnish, func(f TimeFormatter) string { return f.Format(date) }, "1. tammikuuta 2007"},
+		{language.Finnish, func(f TimeFormatter) string { return f.MonthName(1) }, "tammikuu"},
+		{language.Russian, func(f TimeFormatter) string { return f.Format(date) }, "1 января 2007 г."},
+		{language.Japanese, func(f TimeFormatter) string { return f.WeekDayName(TU.Nth(-2)) }, "最後から2番目の火曜日"},
+		{language.Korean, func(f TimeFormatter) string { return f.(ClockFormatter).Clock(17, 30, 0) }, "오후 5:30"},
+		{language.Korean, func(f TimeFormatter) string { return f.Format(date) }, "2007년 1월 1일"},
+		{language.English, func(f TimeFormatter) string { return f.(AbbreviatingFormatter).ShortFormat(date) }, "Jan 1, 2007"},
+		{language.German, func(f TimeFormatter) string { return f.(AbbreviatingFormatter).ShortWeekDayName(MO.Nth(2)) }, "2. Mo."},
+		{language.French, func(f TimeFormatter) string { return f.(AbbreviatingFormatter).ShortMonthName(2) }, "févr."},
+		{language.Russian, func(f TimeFormatter) string { return f.(AbbreviatingFormatter).ShortFormat(date) }, "1 янв. 2007 г."},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang.String()+" "+tt.expected, func(t *testing.T) {
+			if got := tt.got(NewTimeFormatter(tt.lang)); got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
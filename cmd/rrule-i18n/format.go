@@ -0,0 +1,223 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+)
+
+// encoders render messages in the go-i18n message file formats by name.
+var encoders = map[string]func(msgs []*i18n.Message) ([]byte, error){
+	"toml": encodeTOML,
+	"json": encodeJSON,
+	"yaml": encodeYAML,
+}
+
+// messageFields returns the non-empty fields of msg in the order go-i18n writes
+// them, e.g. [["description", "..."], ["other", "and"]].
+func messageFields(msg *i18n.Message) [][2]string {
+	fields := [][2]string{
+		{"description", msg.Description},
+		{"few", msg.Few},
+		{"many", msg.Many},
+		{"one", msg.One},
+		{"other", msg.Other},
+		{"two", msg.Two},
+		{"zero", msg.Zero},
+	}
+
+	nonEmpty := fields[:0]
+	for _, field := range fields {
+		if field[1] != "" {
+			nonEmpty = append(nonEmpty, field)
+		}
+	}
+
+	return nonEmpty
+}
+
+// isSimple reports whether msg is written as a plain string rather than a table.
+func isSimple(msg *i18n.Message) bool {
+	fields := messageFields(msg)
+	return len(fields) == 1 && fields[0][0] == "other"
+}
+
+// encodeTOML writes the simple messages first, then one table per other message.
+func encodeTOML(msgs []*i18n.Message) ([]byte, error) {
+	var simple, tables []string
+	for _, msg := range msgs {
+		if isSimple(msg) {
+			simple = append(simple, fmt.Sprintf("%s = %s", msg.ID, strconv.Quote(msg.Other)))
+			continue
+		}
+
+		lines := []string{fmt.Sprintf("[%s]", msg.ID)}
+		for _, field := range messageFields(msg) {
+			lines = append(lines, fmt.Sprintf("%s = %s", field[0], strconv.Quote(field[1])))
+		}
+		tables = append(tables, strings.Join(lines, "\n"))
+	}
+
+	out := strings.Join(tables, "\n\n") + "\n"
+	if len(simple) > 0 {
+		out = strings.Join(simple, "\n") + "\n\n" + out
+	}
+
+	return []byte(out), nil
+}
+
+func encodeJSON(msgs []*i18n.Message) ([]byte, error) {
+	file := make(map[string]interface{}, len(msgs))
+	for _, msg := range msgs {
+		if isSimple(msg) {
+			file[msg.ID] = msg.Other
+			continue
+		}
+
+		fields := make(map[string]string)
+		for _, field := range messageFields(msg) {
+			fields[field[0]] = field[1]
+		}
+		file[msg.ID] = fields
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(file); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// encodeYAML writes every value as a double-quoted scalar, whose escapes are
+// those of JSON strings.
+func encodeYAML(msgs []*i18n.Message) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, msg := range msgs {
+		if isSimple(msg) {
+			value, err := yamlQuote(msg.Other)
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&buf, "%s: %s\n", msg.ID, value)
+			continue
+		}
+
+		fmt.Fprintf(&buf, "%s:\n", msg.ID)
+		for _, field := range messageFields(msg) {
+			value, err := yamlQuote(field[1])
+			if err != nil {
+				return nil, err
+			}
+			fmt.Fprintf(&buf, "  %s: %s\n", field[0], value)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+func yamlQuote(s string) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return "", err
+	}
+
+	return strings.TrimSuffix(buf.String(), "\n"), nil
+}
+
+// readMessageIDs returns the message IDs of a translation file, chosen by its
+// extension: .toml, .json, .yaml or .yml.
+func readMessageIDs(path string) (map[string]bool, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	file := make(map[string]interface{})
+	switch ext := filepath.Ext(path); ext {
+	case ".toml":
+		err = toml.Unmarshal(data, &file)
+	case ".json":
+		err = json.Unmarshal(data, &file)
+	case ".yaml", ".yml":
+		return yamlKeys(data), nil
+	default:
+		return nil, fmt.Errorf("%s: unsupported format %q", path, ext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	ids := make(map[string]bool, len(file))
+	for id := range file {
+		ids[id] = true
+	}
+
+	return ids, nil
+}
+
+// yamlKeys returns the top-level keys of a go-i18n YAML message file. Only the
+// flat layout of message files is understood: unindented "ID:" lines, each
+// followed by a value or by an indented table of plural forms.
+func yamlKeys(data []byte) map[string]bool {
+	keys := make(map[string]bool)
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" || line[0] == ' ' || line[0] == '\t' || line[0] == '#' || line == "---" {
+			continue
+		}
+
+		i := strings.Index(line, ":")
+		if i < 0 {
+			continue
+		}
+
+		key := strings.TrimSpace(line[:i])
+		if unquoted, err := strconv.Unquote(key); err == nil {
+			key = unquoted
+		} else {
+			key = strings.Trim(key, "'")
+		}
+		keys[key] = true
+	}
+
+	return keys
+}
+
+// compare returns the sorted IDs of the default messages missing from ids and of
+// the ids that are not default messages.
+func compare(msgs []*i18n.Message, ids map[string]bool) (missing, stale []string) {
+	known := make(map[string]bool, len(msgs))
+	for _, msg := range msgs {
+		known[msg.ID] = true
+		if !ids[msg.ID] {
+			missing = append(missing, msg.ID)
+		}
+	}
+
+	for id := range ids {
+		if !known[id] {
+			stale = append(stale, id)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(stale)
+
+	return missing, stale
+}
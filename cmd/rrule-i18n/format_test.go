@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"github.com/xyedo/rrule"
+	"golang.org/x/text/language"
+)
+
+func TestEncodeRoundTrip(t *testing.T) {
+	msgs := rrule.DefaultMessages()
+
+	for _, format := range []string{"toml", "json"} {
+		data, err := encoders[format](msgs)
+		if err != nil {
+			t.Fatalf("%s: encode error: %v", format, err)
+		}
+
+		bundle := i18n.NewBundle(language.English)
+		bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
+		bundle.RegisterUnmarshalFunc("json", json.Unmarshal)
+		file, err := bundle.ParseMessageFileBytes(data, "active.en."+format)
+		if err != nil {
+			t.Fatalf("%s: parse error: %v", format, err)
+		}
+
+		if len(file.Messages) != len(msgs) {
+			t.Fatalf("%s: expected %d messages, got %d", format, len(msgs), len(file.Messages))
+		}
+
+		parsed := make(map[string]*i18n.Message)
+		for _, msg := range file.Messages {
+			parsed[msg.ID] = msg
+		}
+		for _, msg := range msgs {
+			got := parsed[msg.ID]
+			if got == nil || got.Description != msg.Description || got.One != msg.One || got.Other != msg.Other {
+				t.Errorf("%s: expected %+v, got %+v", format, msg, got)
+			}
+		}
+	}
+}
+
+func TestEncodeYAML(t *testing.T) {
+	msgs := []*i18n.Message{
+		{ID: "And", Other: "and"},
+		{ID: "CountTimes", Description: "Slots: Count", One: "once", Other: "{{.Count}} times"},
+		{ID: "Quoted", Other: `say "hi" <b>`},
+	}
+
+	data, err := encodeYAML(msgs)
+	if err != nil {
+		t.Fatalf("encode error: %v", err)
+	}
+
+	expected := `And: "and"
+CountTimes:
+  description: "Slots: Count"
+  one: "once"
+  other: "{{.Count}} times"
+Quoted: "say \"hi\" <b>"
+`
+	if string(data) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, data)
+	}
+
+	keys := yamlKeys(data)
+	if len(keys) != len(msgs) {
+		t.Errorf("expected %d keys, got %v", len(msgs), keys)
+	}
+	for _, msg := range msgs {
+		if !keys[msg.ID] {
+			t.Errorf("expected key %q, got %v", msg.ID, keys)
+		}
+	}
+}
+
+func TestCompare(t *testing.T) {
+	msgs := []*i18n.Message{{ID: "And"}, {ID: "Or"}, {ID: "Set"}}
+
+	missing, stale := compare(msgs, map[string]bool{"Or": true, "Every": true, "Also": true})
+	if len(missing) != 2 || missing[0] != "And" || missing[1] != "Set" {
+		t.Errorf("expected missing [And Set], got %v", missing)
+	}
+	if len(stale) != 2 || stale[0] != "Also" || stale[1] != "Every" {
+		t.Errorf("expected stale [Also Every], got %v", stale)
+	}
+}
@@ -0,0 +1,114 @@
+// Command rrule-i18n helps translators of the ToText messages of the rrule package.
+//
+// Usage:
+//
+//	rrule-i18n dump [-format toml|json|yaml] [-o file]
+//	rrule-i18n diff file...
+//
+// dump writes the English default messages, with their descriptions, in the
+// go-i18n message file format; active.en.toml is generated with
+//
+//	rrule-i18n dump -format toml -o active.en.toml
+//
+// diff compares translation files in TOML, JSON or YAML with the default messages
+// and reports the messages missing from each file and the stale messages that
+// are no longer used. It exits with status 1 if a file differs.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/xyedo/rrule"
+)
+
+func main() {
+	code, err := run(os.Args[1:], os.Stdout)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "rrule-i18n:", err)
+	}
+
+	os.Exit(code)
+}
+
+const usage = `usage:
+	rrule-i18n dump [-format toml|json|yaml] [-o file]
+	rrule-i18n diff file...`
+
+// run executes the command line args, writing reports to w, and returns the exit status.
+func run(args []string, w io.Writer) (int, error) {
+	if len(args) == 0 {
+		return 2, errors.New(usage)
+	}
+
+	switch args[0] {
+	case "dump":
+		return dump(args[1:], w)
+	case "diff":
+		return diff(args[1:], w)
+	default:
+		return 2, fmt.Errorf("unknown command %q\n%s", args[0], usage)
+	}
+}
+
+func dump(args []string, w io.Writer) (int, error) {
+	flags := flag.NewFlagSet("dump", flag.ContinueOnError)
+	flags.SetOutput(io.Discard)
+	format := flags.String("format", "toml", "output format: toml, json or yaml")
+	output := flags.String("o", "", "output file, standard output if empty")
+	if err := flags.Parse(args); err != nil {
+		return 2, fmt.Errorf("%v\n%s", err, usage)
+	}
+
+	encode, ok := encoders[*format]
+	if !ok {
+		return 2, fmt.Errorf("unknown format %q", *format)
+	}
+
+	data, err := encode(rrule.DefaultMessages())
+	if err != nil {
+		return 1, err
+	}
+
+	if *output == "" {
+		_, err = w.Write(data)
+	} else {
+		err = os.WriteFile(*output, data, 0o644)
+	}
+	if err != nil {
+		return 1, err
+	}
+
+	return 0, nil
+}
+
+func diff(files []string, w io.Writer) (int, error) {
+	if len(files) == 0 {
+		return 2, errors.New(usage)
+	}
+
+	code := 0
+	for _, file := range files {
+		ids, err := readMessageIDs(file)
+		if err != nil {
+			return 1, err
+		}
+
+		missing, stale := compare(rrule.DefaultMessages(), ids)
+		for _, id := range missing {
+			fmt.Fprintf(w, "%s: missing %s\n", file, id)
+		}
+		for _, id := range stale {
+			fmt.Fprintf(w, "%s: stale %s\n", file, id)
+		}
+
+		if len(missing) > 0 || len(stale) > 0 {
+			code = 1
+		}
+	}
+
+	return code, nil
+}
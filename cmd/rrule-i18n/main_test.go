@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDumpMatchesActiveEn(t *testing.T) {
+	var out bytes.Buffer
+	if code, err := run([]string{"dump", "-format", "toml"}, &out); code != 0 || err != nil {
+		t.Fatalf("dump exited with %d: %v", code, err)
+	}
+
+	expected, err := os.ReadFile(filepath.Join("..", "..", "active.en.toml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(out.Bytes(), expected) {
+		t.Errorf("active.en.toml is out of date, regenerate it with: go run ./cmd/rrule-i18n dump -o active.en.toml")
+	}
+}
+
+func TestDiff(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join("..", "..", "active.*.toml"))
+	if err != nil || len(files) == 0 {
+		t.Fatalf("no translation files: %v", err)
+	}
+
+	var out bytes.Buffer
+	if code, err := run(append([]string{"diff"}, files...), &out); code != 0 || err != nil {
+		t.Errorf("expected translations in sync, exited with %d: %v\n%s", code, err, out.String())
+	}
+
+	stale := filepath.Join(t.TempDir(), "active.xx.json")
+	if err := os.WriteFile(stale, []byte(`{"Every": "every", "And": "and"}`), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	out.Reset()
+	code, err := run([]string{"diff", stale}, &out)
+	if code != 1 || err != nil {
+		t.Fatalf("expected exit status 1, got %d: %v", code, err)
+	}
+	if !bytes.Contains(out.Bytes(), []byte(stale+": stale Every\n")) {
+		t.Errorf("expected Every to be stale, got\n%s", out.String())
+	}
+	if !bytes.Contains(out.Bytes(), []byte(stale+": missing Or\n")) {
+		t.Errorf("expected Or to be missing, got\n%s", out.String())
+	}
+	if bytes.Contains(out.Bytes(), []byte(": missing And\n")) {
+		t.Errorf("expected And to be translated, got\n%s", out.String())
+	}
+}
+
+func TestRunUsage(t *testing.T) {
+	for _, args := range [][]string{nil, {"extract"}, {"diff"}, {"dump", "-format", "xml"}} {
+		if code, err := run(args, &bytes.Buffer{}); code != 2 || err == nil {
+			t.Errorf("%v: expected exit status 2 with an error, got %d: %v", args, code, err)
+		}
+	}
+}
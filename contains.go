@@ -0,0 +1,219 @@
+package rrule
+
+import (
+	"time"
+)
+
+// Contains reports whether t is an occurrence of the rule, without generating
+// the occurrences before it.
+//
+// The period of t is checked against INTERVAL by arithmetic and its day against
+// the BYxxx masks of its year, then its time of day, so that the cost does not
+// grow with the distance from DTSTART. Rules with BYSETPOS also build the
+// occurrences of that period. For a rule with a COUNT, its last occurrence is
+// looked up once, as by Nth, and kept.
+func (r *RRule) Contains(t time.Time) bool {
+	if t.Before(r.dtstart) || t.After(r.until) || !r.matches(t) {
+		return false
+	}
+
+	if r.count > 0 {
+		r.found.mu.Lock()
+		countEnd := r.found.countEnd
+		r.found.mu.Unlock()
+		if countEnd.IsZero() {
+			countEnd = r.until
+			if last, ok := r.nth(r.count - 1); ok {
+				countEnd = last
+			}
+			r.found.mu.Lock()
+			r.found.countEnd = countEnd
+			r.found.mu.Unlock()
+		}
+		return !t.After(countEnd)
+	}
+
+	return true
+}
+
+// Contains reports whether t is an occurrence of the set: an RDATE value or an
+// occurrence of the RRULE, as by RRule.Contains, that is not an EXDATE value.
+func (set *Set) Contains(t time.Time) bool {
+	for _, exdate := range set.exdate {
+		if exdate.Equal(t) {
+			return false
+		}
+	}
+	for _, rdate := range set.rdate {
+		if rdate.Equal(t) {
+			return true
+		}
+	}
+
+	return set.rrule != nil && set.rrule.Contains(t)
+}
+
+// matches reports whether the iteration of the rule, regardless of DTSTART, COUNT
+// and UNTIL, generates t.
+func (r *RRule) matches(t time.Time) bool {
+	if t.Nanosecond() != 0 {
+		return false
+	}
+
+	lt := t.In(r.dtstart.Location())
+	if r.freq >= HOURLY {
+		// An occurrence is a duration from the midnight of its day, which a
+		// daylight saving time transition may move to the next day.
+		year, month, day := lt.Date()
+		for _, date := range []time.Time{
+			time.Date(year, month, day, 0, 0, 0, 0, lt.Location()),
+			time.Date(year, month, day-1, 0, 0, 0, 0, lt.Location()),
+		} {
+			if elapsed := t.Sub(date); 0 <= elapsed && elapsed < 24*time.Hour && r.matchesTime(date, int(elapsed/time.Second)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	year, month, day, ok := r.period(lt)
+	if !ok {
+		return false
+	}
+
+	info := iterInfo{rrule: r}
+	info.rebuild(year, month)
+	start, end := info.calcDaySet(r.freq, year, month, day)
+	i := dayNumber(lt) - dayNumber(info.firstyday)
+	if i < start || i >= end || info.excluded(i) {
+		return false
+	}
+
+	if len(r.bysetpos) == 0 {
+		for _, tm := range r.timeset {
+			if r.occurrence(lt, tm).Equal(t) {
+				return true
+			}
+		}
+		return false
+	}
+
+	var days []int
+	for j := start; j < end; j++ {
+		if !info.excluded(j) {
+			days = append(days, j)
+		}
+	}
+	for _, pos := range r.bysetpos {
+		var daypos, timepos int
+		if pos < 0 {
+			daypos, timepos = divmod(pos, len(r.timeset))
+		} else {
+			daypos, timepos = divmod(pos-1, len(r.timeset))
+		}
+		j, err := pySubscript(days, daypos)
+		if err == nil && j == i && r.occurrence(lt, r.timeset[timepos]).Equal(t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// period returns the day the iteration starts the period of lt at, for a rule of
+// daily or coarser frequency, or false if INTERVAL skips that period.
+func (r *RRule) period(lt time.Time) (int, time.Month, int, bool) {
+	year, month, day := lt.Date()
+	dtyear, dtmonth, dtday := r.dtstart.Date()
+
+	var periods int
+	switch r.freq {
+	case YEARLY:
+		periods = year - dtyear
+		month = dtmonth
+	case MONTHLY:
+		periods = (year-dtyear)*12 + int(month-dtmonth)
+	case WEEKLY:
+		// The first period starts at DTSTART, the others on WKST.
+		weekStart := dayNumber(lt) - pymod(toPyWeekday(lt.Weekday())-r.wkst, 7)
+		dtWeekStart := dayNumber(r.dtstart) - pymod(toPyWeekday(r.dtstart.Weekday())-r.wkst, 7)
+		periods = (weekStart - dtWeekStart) / 7
+		if periods == 0 {
+			year, month, day = dtyear, dtmonth, dtday
+		} else {
+			year, month, day = fromDayNumber(weekStart)
+		}
+	case DAILY:
+		periods = dayNumber(lt) - dayNumber(r.dtstart)
+	}
+
+	return year, month, day, pymod(periods, r.interval) == 0
+}
+
+// matchesTime reports whether a rule finer than daily generates the occurrence
+// at seconds from the midnight date.
+func (r *RRule) matchesTime(date time.Time, seconds int) bool {
+	hour, minute, second := seconds/3600, seconds/60%60, seconds%60
+	if len(r.byhour) != 0 && !contains(r.byhour, hour) ||
+		len(r.byminute) != 0 && !contains(r.byminute, minute) ||
+		len(r.bysecond) != 0 && !contains(r.bysecond, second) {
+		return false
+	}
+
+	if !r.alignedTime(date, seconds) {
+		return false
+	}
+
+	info := iterInfo{rrule: r}
+	info.rebuild(date.Year(), date.Month())
+	if info.excluded(date.YearDay() - 1) {
+		return false
+	}
+	if len(r.bysetpos) == 0 {
+		return true
+	}
+
+	// BYSETPOS selects among the times of the hour or minute of the occurrence.
+	var timeset []time.Time
+	info.fillTimeSet(&timeset, r.freq, hour, minute, second)
+	for i, tm := range timeset {
+		if h, m, s := tm.Clock(); h != hour || m != minute || s != second {
+			continue
+		}
+		for _, pos := range r.bysetpos {
+			if pos > 0 && pos-1 == i || pos < 0 && len(timeset)+pos == i {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// alignedTime reports whether the occurrence of a rule finer than daily at
+// seconds from the midnight date is a multiple of INTERVAL from DTSTART.
+func (r *RRule) alignedTime(date time.Time, seconds int) bool {
+	hour, minute := seconds/3600, seconds/60%60
+	dthour, dtminute, dtsecond := r.dtstart.Clock()
+	days := dayNumber(date) - dayNumber(r.dtstart)
+
+	var steps int
+	switch r.freq {
+	case HOURLY:
+		steps = days*24 + hour - dthour
+	case MINUTELY:
+		steps = days*1440 + hour*60 + minute - dthour*60 - dtminute
+	default:
+		steps = days*86400 + seconds - dthour*3600 - dtminute*60 - dtsecond
+	}
+
+	return pymod(steps, r.interval) == 0
+}
+
+// occurrence returns the occurrence at the time of day tm on the date of lt.
+func (r *RRule) occurrence(lt, tm time.Time) time.Time {
+	year, month, day := lt.Date()
+	hour, minute, second := tm.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, tm.Nanosecond(), tm.Location())
+}
@@ -0,0 +1,79 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestContains(t *testing.T) {
+	var rules = []string{
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;COUNT=10;BYMONTH=1,3;BYDAY=1TU,-1TH",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;UNTIL=20100101T000000Z;BYWEEKNO=1,20;BYDAY=MO,SU",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=2;COUNT=10;BYYEARDAY=1,100,-1",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;COUNT=10;BYEASTER=-2,1",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=3;COUNT=20;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1,1",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=MONTHLY;COUNT=20;BYMONTHDAY=-1,15;BYHOUR=9,18",
+		"DTSTART:19971230T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=3;WKST=SU;COUNT=20;BYDAY=TU,SU",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;COUNT=20;BYDAY=MO,WE,FR;BYSETPOS=2",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=DAILY;INTERVAL=5;COUNT=30;BYMONTH=9,10,11",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=5;COUNT=50;BYDAY=MO,FR;BYMINUTE=0,30",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=HOURLY;COUNT=30;BYMINUTE=10,20,30;BYSETPOS=-1",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=MINUTELY;INTERVAL=7;COUNT=100;BYHOUR=9,10",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=SECONDLY;INTERVAL=1000;COUNT=100;BYSECOND=0,20,40",
+		"DTSTART;TZID=America/New_York:20240301T023000\nRRULE:FREQ=DAILY;COUNT=40;BYHOUR=1,2,3",
+		"DTSTART;TZID=America/New_York:20241101T000000\nRRULE:FREQ=HOURLY;INTERVAL=5;COUNT=40",
+		"DTSTART;TZID=America/New_York:20240308T000000\nRRULE:FREQ=MINUTELY;INTERVAL=45;COUNT=200",
+	}
+
+	for _, rule := range rules {
+		r, err := StrToRRule(rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", rule, err)
+		}
+
+		all := r.All()
+		if len(all) == 0 {
+			t.Fatalf("%q: expected occurrences", rule)
+		}
+		occurrences := make(map[int64]bool, len(all))
+		for _, dt := range all {
+			occurrences[dt.Unix()] = true
+		}
+
+		for _, dt := range all {
+			for _, candidate := range []time.Time{
+				dt, dt.Add(-time.Second), dt.Add(time.Minute), dt.Add(time.Hour), dt.AddDate(0, 0, 1), dt.AddDate(0, 0, -7),
+			} {
+				if got, want := r.Contains(candidate), occurrences[candidate.Unix()]; got != want {
+					t.Errorf("%q: Contains(%v) expected %v, got %v", rule, candidate, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestSetContains(t *testing.T) {
+	set, err := StrToRRuleSet("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=5\n" +
+		"RDATE:20240110T090000Z\nEXDATE:20240102T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	var tests = []struct {
+		t        time.Time
+		expected bool
+	}{
+		{time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 1, 6, 9, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		if got := set.Contains(tt.t); got != tt.expected {
+			t.Errorf("Contains(%v) expected %v, got %v", tt.t, tt.expected, got)
+		}
+	}
+}
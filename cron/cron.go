@@ -0,0 +1,533 @@
+// Package cron converts cron expressions to recurrence rules and back.
+//
+// Expressions have 5 fields, minute hour day-of-month month day-of-week, or 6
+// fields with the seconds first. Fields accept lists, ranges, steps, the names
+// JAN-DEC and SUN-SAT, and "?" for "*". Days of the week are numbered from 0 for
+// Sunday to 6, 7 being Sunday as well. The extensions L, W and # are supported
+// where a rule can express them: "L" and "L-n" for the last days of the month,
+// "LW" and "1W" for its last and first weekday, "5L" for its last Friday and
+// "5#3" for its third Friday.
+package cron
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xyedo/rrule"
+	"github.com/xyedo/rrule/internal/field"
+)
+
+// UnrepresentableError is returned by FromROption for a rule with parts cron
+// cannot express.
+type UnrepresentableError struct {
+	// Parts are the names of the parts, e.g. "COUNT" or "BYSETPOS".
+	Parts []string
+}
+
+func (e *UnrepresentableError) Error() string {
+	return "cron: cannot express " + strings.Join(e.Parts, ", ")
+}
+
+var macros = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+var monthNames = map[string]int{
+	"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+	"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+}
+
+var weekdayNames = map[string]int{
+	"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+}
+
+// weekdays maps the cron days of the week, from Sunday, to rule weekdays.
+var weekdays = [...]rrule.Weekday{rrule.SU, rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR, rrule.SA}
+
+// syntax is how cron writes the values of its fields, with steps "*/n" from the
+// first value.
+var syntax = field.Syntax{
+	Name:  "cron",
+	Range: "-",
+	Step:  "step",
+	Repeat: func(first, step, min int) string {
+		if first != min {
+			return ""
+		}
+		return "*/" + strconv.Itoa(step)
+	},
+}
+
+// ToROption converts the cron expression expr to the options of a rule starting
+// at dtstart, which generates the times expr matches from dtstart on.
+//
+// Cron matches a day when either its day of the month or its day of the week
+// matches, if both are restricted, which a rule cannot express, so an error is
+// returned unless one of them is "*" or "?". W is only supported as LW and 1W,
+// and a day of the week with # or L cannot be listed with plain days.
+func ToROption(expr string, dtstart time.Time) (rrule.ROption, error) {
+	option := rrule.ROption{Dtstart: dtstart}
+
+	expr = strings.TrimSpace(expr)
+	if macro, ok := macros[strings.ToLower(expr)]; ok {
+		expr = macro
+	}
+	fields := strings.Fields(expr)
+	if len(fields) == 5 {
+		fields = append([]string{"0"}, fields...)
+	}
+	if len(fields) != 6 {
+		return option, fmt.Errorf("cron: expected 5 or 6 fields, got %d in %q", len(fields), expr)
+	}
+
+	second, err := parseField(fields[0], 0, 59, nil)
+	if err != nil {
+		return option, err
+	}
+	minute, err := parseField(fields[1], 0, 59, nil)
+	if err != nil {
+		return option, err
+	}
+	hour, err := parseField(fields[2], 0, 23, nil)
+	if err != nil {
+		return option, err
+	}
+	month, err := parseField(fields[4], 1, 12, monthNames)
+	if err != nil {
+		return option, err
+	}
+
+	var setpos, first bool
+	var monthday field.Field
+	switch dom := strings.ToUpper(fields[3]); {
+	case dom == "LW":
+		setpos = true
+	case dom == "1W":
+		setpos, first = true, true
+	default:
+		if monthday, err = parseMonthDays(dom); err != nil {
+			return option, err
+		}
+	}
+
+	weekday, nth, err := parseWeekdays(fields[5])
+	if err != nil {
+		return option, err
+	}
+
+	if !setpos && !monthday.All && !weekday.All {
+		return option, fmt.Errorf("cron: %q matches its day of the month or its day of the week, which a rule cannot express", expr)
+	}
+	if setpos && !weekday.All {
+		return option, fmt.Errorf("cron: %q restricts the day of the week of %s", expr, fields[3])
+	}
+
+	switch {
+	case setpos || len(nth) != 0:
+		option.Freq = rrule.MONTHLY
+	case second.All:
+		option.Freq = rrule.SECONDLY
+	case minute.All:
+		option.Freq = rrule.MINUTELY
+	case hour.All:
+		option.Freq = rrule.HOURLY
+	default:
+		option.Freq = rrule.DAILY
+	}
+
+	// Monthly rules list every time of the day, finer rules step through them.
+	if option.Freq == rrule.MONTHLY || !second.All {
+		option.Bysecond = second.Values
+	}
+	if option.Freq == rrule.MONTHLY || !minute.All {
+		option.Byminute = minute.Values
+	}
+	if option.Freq == rrule.MONTHLY || !hour.All {
+		option.Byhour = hour.Values
+	}
+	if !month.All {
+		option.Bymonth = month.Values
+	}
+
+	if setpos {
+		option.Byweekday = []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR}
+
+		// BYSETPOS counts the times of the days of the month.
+		times := len(second.Values) * len(minute.Values) * len(hour.Values)
+		if times > 366 {
+			return option, fmt.Errorf("cron: %q has more than 366 times a day with %s", expr, fields[3])
+		}
+		for i := 1; i <= times; i++ {
+			if first {
+				option.Bysetpos = append(option.Bysetpos, i)
+			} else {
+				option.Bysetpos = append(option.Bysetpos, i-times-1)
+			}
+		}
+		if first {
+			// The first weekday is at the latest the 3rd, after a weekend.
+			option.Bymonthday = []int{1, 2, 3}
+		}
+		return option, nil
+	}
+
+	if !monthday.All {
+		option.Bymonthday = monthday.Values
+	}
+	if len(nth) != 0 {
+		option.Byweekday = nth
+	} else if !weekday.All {
+		for _, d := range weekday.Values {
+			option.Byweekday = append(option.Byweekday, weekdays[d])
+		}
+	}
+
+	return option, nil
+}
+
+// ToSet converts the cron expression expr to a set with the rule of ToROption.
+func ToSet(expr string, dtstart time.Time) (*rrule.Set, error) {
+	option, err := ToROption(expr, dtstart)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := rrule.NewRRule(option)
+	if err != nil {
+		return nil, fmt.Errorf("cron: %v", err)
+	}
+
+	set := &rrule.Set{}
+	set.RRule(r)
+	return set, nil
+}
+
+// parseField parses a field of values from min to max, named by names, where "?"
+// is "*".
+func parseField(s string, min, max int, names map[string]int) (field.Field, error) {
+	if s == "?" {
+		s = "*"
+	}
+	return syntax.Parse(s, field.Bounds{Min: min, Max: max, Names: names})
+}
+
+// parseMonthDays parses the day of the month field, where L is the last day of
+// the month and L-n the nth day before it.
+func parseMonthDays(s string) (field.Field, error) {
+	var plain []string
+	var last []int
+	for _, item := range strings.Split(s, ",") {
+		switch {
+		case item == "L":
+			last = append(last, -1)
+		case strings.HasPrefix(item, "L-"):
+			n, err := strconv.Atoi(item[2:])
+			if err != nil || n < 0 || n > 30 {
+				return field.Field{}, fmt.Errorf("cron: bad day of the month %q", item)
+			}
+			last = append(last, -n-1)
+		case strings.HasSuffix(item, "W"):
+			return field.Field{}, fmt.Errorf("cron: nearest weekday %q is only supported as LW or 1W", item)
+		default:
+			plain = append(plain, item)
+		}
+	}
+
+	days := field.Field{}
+	if len(plain) != 0 {
+		var err error
+		if days, err = parseField(strings.Join(plain, ","), 1, 31, nil); err != nil {
+			return field.Field{}, err
+		}
+	}
+	if len(last) != 0 {
+		days.All = false
+		days.Values = append(days.Values, last...)
+	}
+
+	return days, nil
+}
+
+// parseWeekdays parses the day of the week field into plain days, from 0 for
+// Sunday, or into nth weekdays for d#n and dL.
+func parseWeekdays(s string) (field.Field, []rrule.Weekday, error) {
+	var plain []string
+	var nth []rrule.Weekday
+	for _, item := range strings.Split(strings.ToUpper(s), ",") {
+		n := 0
+		day := item
+		if i := strings.Index(item, "#"); i >= 0 {
+			var err error
+			if n, err = strconv.Atoi(item[i+1:]); err != nil || n < 1 || n > 5 {
+				return field.Field{}, nil, fmt.Errorf("cron: bad nth day of the week %q", item)
+			}
+			day = item[:i]
+		} else if len(item) > 1 && strings.HasSuffix(item, "L") {
+			n, day = -1, item[:len(item)-1]
+		}
+
+		if n == 0 {
+			plain = append(plain, item)
+			continue
+		}
+		d, err := syntax.Value(day, field.Bounds{Min: 0, Max: 7, Names: weekdayNames})
+		if err != nil {
+			return field.Field{}, nil, err
+		}
+		nth = append(nth, weekdays[d%7].Nth(n))
+	}
+
+	if len(nth) != 0 && len(plain) != 0 {
+		return field.Field{}, nil, fmt.Errorf("cron: %q lists days of the week with and without # or L", s)
+	}
+	if len(nth) != 0 {
+		return field.Field{}, nth, nil
+	}
+
+	days, err := parseField(strings.Join(plain, ","), 0, 7, weekdayNames)
+	if err != nil {
+		return field.Field{}, nil, err
+	}
+	for i, d := range days.Values {
+		days.Values[i] = d % 7
+	}
+	days.Values = field.Unique(days.Values)
+	days.All = len(days.Values) == 7
+
+	return days, nil, nil
+}
+
+// FromROption converts the options of a rule to a cron expression matching its
+// occurrences, with 5 fields, or 6 when the seconds are not 0. DTSTART only
+// supplies the parts the rule implies from it, since cron expressions have no
+// start.
+//
+// It returns an *UnrepresentableError listing the parts of the rule cron cannot
+// express: COUNT, UNTIL, BYYEARDAY, BYWEEKNO, BYEASTER, an INTERVAL other than a
+// step dividing the hours, minutes or seconds evenly from DTSTART, nth weekdays
+// other than #1 to #5 and L of a month, BYMONTHDAY along with BYDAY, and a
+// BYSETPOS other than the last or first weekday of the month.
+func FromROption(option rrule.ROption) (string, error) {
+	var unsupported []string
+	unsupport := func(part string) {
+		for _, p := range unsupported {
+			if p == part {
+				return
+			}
+		}
+		unsupported = append(unsupported, part)
+	}
+
+	if option.Count != 0 {
+		unsupport("COUNT")
+	}
+	if !option.Until.IsZero() {
+		unsupport("UNTIL")
+	}
+	if len(option.Byyearday) != 0 {
+		unsupport("BYYEARDAY")
+	}
+	if len(option.Byweekno) != 0 {
+		unsupport("BYWEEKNO")
+	}
+	if len(option.Byeaster) != 0 {
+		unsupport("BYEASTER")
+	}
+
+	dtstart := option.Dtstart
+	if dtstart.IsZero() {
+		dtstart = time.Now().UTC()
+	}
+	interval := option.Interval
+	if interval < 1 {
+		interval = 1
+	}
+
+	if interval > 1 && option.Freq < rrule.HOURLY {
+		unsupport("INTERVAL")
+	}
+	// timeField formats the time part of freq. A step of the rule frequency
+	// keeps counting across days only when it divides the units of the day.
+	timeField := func(freq rrule.Frequency, list []int, value, units int) string {
+		switch {
+		case option.Freq == freq && interval > 1 && (len(list) != 0 || units%interval != 0 || value%interval != 0):
+			unsupport("INTERVAL")
+		case len(list) != 0:
+			return syntax.Format(list, 0, units-1)
+		case option.Freq < freq:
+			return strconv.Itoa(value)
+		case option.Freq == freq && interval > 1:
+			return "*/" + strconv.Itoa(interval)
+		}
+		return "*"
+	}
+	second := timeField(rrule.SECONDLY, option.Bysecond, dtstart.Second(), 60)
+	minute := timeField(rrule.MINUTELY, option.Byminute, dtstart.Minute(), 60)
+	hour := timeField(rrule.HOURLY, option.Byhour, dtstart.Hour(), 24)
+
+	bymonth, bymonthday, byweekday := option.Bymonth, option.Bymonthday, option.Byweekday
+	if len(bymonthday) == 0 && len(byweekday) == 0 && len(option.Byyearday) == 0 &&
+		len(option.Byweekno) == 0 && len(option.Byeaster) == 0 {
+		switch option.Freq {
+		case rrule.YEARLY:
+			if len(bymonth) == 0 {
+				bymonth = []int{int(dtstart.Month())}
+			}
+			bymonthday = []int{dtstart.Day()}
+		case rrule.MONTHLY:
+			bymonthday = []int{dtstart.Day()}
+		case rrule.WEEKLY:
+			byweekday = []rrule.Weekday{weekdays[dtstart.Weekday()]}
+		}
+	}
+
+	month := syntax.Format(bymonth, 1, 12)
+	dom, dow := "*", "*"
+	if len(option.Bysetpos) != 0 {
+		dom = workdayPosition(option, bymonthday, byweekday, timesPerDay(option))
+		if dom == "" {
+			unsupport("BYSETPOS")
+		}
+	} else {
+		if len(bymonthday) != 0 && len(byweekday) != 0 {
+			unsupport("BYMONTHDAY")
+			unsupport("BYDAY")
+		}
+		if len(bymonthday) != 0 {
+			dom = formatMonthDays(bymonthday)
+		}
+		if len(byweekday) != 0 {
+			var ok bool
+			if dow, ok = formatWeekdays(byweekday, option.Freq == rrule.MONTHLY || option.Freq == rrule.YEARLY && len(bymonth) != 0,
+				option.Freq > rrule.MONTHLY); !ok {
+				unsupport("BYDAY")
+			}
+		}
+	}
+
+	if len(unsupported) != 0 {
+		return "", &UnrepresentableError{Parts: unsupported}
+	}
+
+	fields := []string{minute, hour, dom, month, dow}
+	if second != "0" {
+		fields = append([]string{second}, fields...)
+	}
+	return strings.Join(fields, " "), nil
+}
+
+// workdayPosition returns LW or 1W for options selecting the last or first
+// weekday of the month, or "" for other options.
+func workdayPosition(option rrule.ROption, bymonthday []int, byweekday []rrule.Weekday, times int) string {
+	positions := field.Unique(option.Bysetpos)
+	if option.Freq != rrule.MONTHLY || len(positions) != times {
+		return ""
+	}
+
+	var days []int
+	for i := range byweekday {
+		if byweekday[i].N() != 0 {
+			return ""
+		}
+		days = append(days, byweekday[i].Day())
+	}
+	if !equal(field.Unique(days), []int{0, 1, 2, 3, 4}) {
+		return ""
+	}
+
+	switch {
+	case len(bymonthday) == 0 && positions[0] == -times && positions[times-1] == -1:
+		return "LW"
+	case equal(field.Unique(bymonthday), []int{1, 2, 3}) && positions[0] == 1 && positions[times-1] == times:
+		return "1W"
+	}
+	return ""
+}
+
+// timesPerDay returns the number of times of the day of monthly options.
+func timesPerDay(option rrule.ROption) int {
+	times := 1
+	for _, list := range [][]int{option.Byhour, option.Byminute, option.Bysecond} {
+		if len(list) != 0 {
+			times *= len(field.Unique(list))
+		}
+	}
+	return times
+}
+
+// formatMonthDays returns the days of the month as a cron field, with L for the
+// days counted from the end of the month.
+func formatMonthDays(days []int) string {
+	var plain []int
+	var last []string
+	for _, d := range field.Unique(days) {
+		switch {
+		case d == -1:
+			last = append(last, "L")
+		case d < 0:
+			last = append(last, fmt.Sprintf("L-%d", -d-1))
+		default:
+			plain = append(plain, d)
+		}
+	}
+
+	var items []string
+	if len(plain) != 0 {
+		items = append(items, syntax.Format(plain, 1, 31))
+	}
+	items = append(items, last...)
+	return strings.Join(items, ",")
+}
+
+// formatWeekdays returns the weekdays as a cron field. Nth weekdays are written
+// with # or L when monthly, and ignored when ignoreN, as by rules finer than
+// monthly. It returns false for weekdays cron cannot express.
+func formatWeekdays(list []rrule.Weekday, monthly, ignoreN bool) (string, bool) {
+	var plain []int
+	var nth []string
+	for i := range list {
+		d := (list[i].Day() + 1) % 7
+		switch n := list[i].N(); {
+		case n == 0 || ignoreN:
+			plain = append(plain, d)
+		case !monthly:
+			return "", false
+		case n == -1:
+			nth = append(nth, fmt.Sprintf("%dL", d))
+		case n >= 1 && n <= 5:
+			nth = append(nth, fmt.Sprintf("%d#%d", d, n))
+		default:
+			return "", false
+		}
+	}
+
+	if len(nth) != 0 {
+		if len(plain) != 0 {
+			return "", false
+		}
+		sort.Strings(nth)
+		return strings.Join(nth, ","), true
+	}
+	return syntax.Format(plain, 0, 6), true
+}
+
+func equal(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,225 @@
+package cron
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xyedo/rrule"
+)
+
+var dtstart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestToROption(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected rrule.ROption
+	}{
+		{"@daily", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0}}},
+		{"*/15 * * * *", rrule.ROption{Freq: rrule.HOURLY, Bysecond: []int{0}, Byminute: []int{0, 15, 30, 45}}},
+		{"* * * * *", rrule.ROption{Freq: rrule.MINUTELY, Bysecond: []int{0}}},
+		{"* * * * * *", rrule.ROption{Freq: rrule.SECONDLY}},
+		{"30 9 * * MON-FRI", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{30}, Byhour: []int{9},
+			Byweekday: []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR}}},
+		{"0 0 1,15 JAN/6 ?", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Bymonth: []int{1, 7}, Bymonthday: []int{1, 15}}},
+		{"0 12 L,L-2 * *", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{12},
+			Bymonthday: []int{-1, -3}}},
+		{"0 0 * * 0,7", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Byweekday: []rrule.Weekday{rrule.SU}}},
+		{"0 9 * * 5#3", rrule.ROption{Freq: rrule.MONTHLY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{9},
+			Byweekday: []rrule.Weekday{rrule.FR.Nth(3)}}},
+		{"0 9 * * 5L", rrule.ROption{Freq: rrule.MONTHLY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{9},
+			Byweekday: []rrule.Weekday{rrule.FR.Nth(-1)}}},
+		{"0 9,17 LW * *", rrule.ROption{Freq: rrule.MONTHLY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{9, 17},
+			Byweekday: []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR}, Bysetpos: []int{-2, -1}}},
+		{"0 9 1W * *", rrule.ROption{Freq: rrule.MONTHLY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{9},
+			Bymonthday: []int{1, 2, 3}, Byweekday: []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR},
+			Bysetpos: []int{1}}},
+	}
+
+	for _, test := range tests {
+		option, err := ToROption(test.expr, dtstart)
+		if err != nil {
+			t.Errorf("%q: %v", test.expr, err)
+			continue
+		}
+		test.expected.Dtstart = dtstart
+		if !reflect.DeepEqual(option, test.expected) {
+			t.Errorf("%q: expected %+v, got %+v", test.expr, test.expected, option)
+		}
+	}
+}
+
+func TestToSet(t *testing.T) {
+	tests := []struct {
+		expr     string
+		expected []time.Time
+	}{
+		{"0 9 LW * *", []time.Time{
+			time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 29, 9, 0, 0, 0, time.UTC),
+		}},
+		{"0 9 1W * *", []time.Time{
+			time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 4, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC),
+			time.Date(2024, 6, 3, 9, 0, 0, 0, time.UTC),
+		}},
+		{"30 10 * * 1#2", []time.Time{
+			time.Date(2024, 1, 8, 10, 30, 0, 0, time.UTC),
+			time.Date(2024, 2, 12, 10, 30, 0, 0, time.UTC),
+		}},
+		{"0 */8 L * *", []time.Time{
+			time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 31, 8, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 31, 16, 0, 0, 0, time.UTC),
+			time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		}},
+		{"15 */20 * * * *", []time.Time{
+			time.Date(2024, 1, 1, 0, 0, 15, 0, time.UTC),
+			time.Date(2024, 1, 1, 0, 20, 15, 0, time.UTC),
+			time.Date(2024, 1, 1, 0, 40, 15, 0, time.UTC),
+			time.Date(2024, 1, 1, 1, 0, 15, 0, time.UTC),
+		}},
+	}
+
+	for _, test := range tests {
+		set, err := ToSet(test.expr, dtstart)
+		if err != nil {
+			t.Errorf("%q: %v", test.expr, err)
+			continue
+		}
+		var got []time.Time
+		next := set.Iterator()
+		for range test.expected {
+			v, ok := next()
+			if !ok {
+				break
+			}
+			got = append(got, v)
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected %v, got %v", test.expr, test.expected, got)
+		}
+	}
+}
+
+func TestToROptionError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"0 0 1 * MON",
+		"0 0 15W * *",
+		"0 0 LW * MON",
+		"0 0 * * MON,5#3",
+		"0 0 * * 5#6",
+		"* * LW * * *",
+	} {
+		if _, err := ToROption(expr, dtstart); err == nil {
+			t.Errorf("%q: expected an error", expr)
+		}
+	}
+}
+
+func TestFromROption(t *testing.T) {
+	tests := []struct {
+		option   rrule.ROption
+		expected string
+	}{
+		{rrule.ROption{Freq: rrule.DAILY, Dtstart: time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC)}, "30 9 * * *"},
+		{rrule.ROption{Freq: rrule.WEEKLY, Dtstart: time.Date(2024, 1, 5, 9, 30, 0, 0, time.UTC)}, "30 9 * * 5"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Dtstart: time.Date(2024, 1, 5, 9, 30, 0, 0, time.UTC)}, "30 9 5 * *"},
+		{rrule.ROption{Freq: rrule.YEARLY, Dtstart: time.Date(2024, 3, 5, 9, 30, 0, 0, time.UTC)}, "30 9 5 3 *"},
+		{rrule.ROption{Freq: rrule.HOURLY, Interval: 6, Dtstart: dtstart}, "0 */6 * * *"},
+		{rrule.ROption{Freq: rrule.MINUTELY, Interval: 15, Byhour: []int{9, 10, 11, 17}, Dtstart: dtstart}, "*/15 9-11,17 * * *"},
+		{rrule.ROption{Freq: rrule.SECONDLY, Dtstart: dtstart}, "* * * * * *"},
+		{rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{30}, Dtstart: dtstart}, "30 0 0 * * *"},
+		{rrule.ROption{Freq: rrule.WEEKLY, Byweekday: []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.SU}, Dtstart: dtstart},
+			"0 0 * * 0-3"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Bymonthday: []int{1, -1, -3}, Dtstart: dtstart}, "0 0 1,L-2,L * *"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Byweekday: []rrule.Weekday{rrule.FR.Nth(-1), rrule.MO.Nth(1)}, Dtstart: dtstart},
+			"0 0 * * 1#1,5L"},
+		{rrule.ROption{Freq: rrule.YEARLY, Bymonth: []int{11}, Byweekday: []rrule.Weekday{rrule.TH.Nth(4)}, Dtstart: dtstart},
+			"0 0 * 11 4#4"},
+	}
+
+	for _, test := range tests {
+		expr, err := FromROption(test.option)
+		if err != nil {
+			t.Errorf("%v: %v", test.option, err)
+		} else if expr != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, expr)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, expr := range []string{
+		"30 9 * * 1-5",
+		"0 */6 * * *",
+		"0 0 1,15 1,7 *",
+		"0 12 L-2,L * *",
+		"0 9 * * 5#3",
+		"0 9 * * 5L",
+		"0 9,17 LW * *",
+		"0 9 1W * *",
+		"15 */20 * * * *",
+	} {
+		option, err := ToROption(expr, dtstart)
+		if err != nil {
+			t.Errorf("%q: %v", expr, err)
+			continue
+		}
+		got, err := FromROption(option)
+		if err != nil {
+			t.Errorf("%q: %v", expr, err)
+		} else if got != expr {
+			t.Errorf("expected %q, got %q", expr, got)
+		}
+	}
+}
+
+func TestFromROptionUnrepresentable(t *testing.T) {
+	tests := []struct {
+		option   rrule.ROption
+		expected []string
+	}{
+		{rrule.ROption{Freq: rrule.DAILY, Count: 3, Byyearday: []int{1}, Dtstart: dtstart}, []string{"COUNT", "BYYEARDAY"}},
+		{rrule.ROption{Freq: rrule.DAILY, Interval: 2, Dtstart: dtstart}, []string{"INTERVAL"}},
+		{rrule.ROption{Freq: rrule.MINUTELY, Interval: 7, Dtstart: dtstart}, []string{"INTERVAL"}},
+		{rrule.ROption{Freq: rrule.HOURLY, Interval: 2, Byhour: []int{9}, Dtstart: dtstart}, []string{"INTERVAL"}},
+		{rrule.ROption{Freq: rrule.MONTHLY, Bymonthday: []int{1}, Byweekday: []rrule.Weekday{rrule.MO}, Dtstart: dtstart},
+			[]string{"BYMONTHDAY", "BYDAY"}},
+		{rrule.ROption{Freq: rrule.YEARLY, Byweekday: []rrule.Weekday{rrule.MO.Nth(20)}, Dtstart: dtstart}, []string{"BYDAY"}},
+		{rrule.ROption{Freq: rrule.MONTHLY, Byweekday: []rrule.Weekday{rrule.MO}, Bysetpos: []int{2}, Dtstart: dtstart},
+			[]string{"BYSETPOS"}},
+		{rrule.ROption{Freq: rrule.WEEKLY, Until: dtstart, Byweekno: []int{1}, Byeaster: []int{0}, Dtstart: dtstart},
+			[]string{"UNTIL", "BYWEEKNO", "BYEASTER"}},
+	}
+
+	for _, test := range tests {
+		_, err := FromROption(test.option)
+		e, ok := err.(*UnrepresentableError)
+		if !ok {
+			t.Errorf("%v: expected an UnrepresentableError, got %v", test.option, err)
+			continue
+		}
+		if !reflect.DeepEqual(e.Parts, test.expected) {
+			t.Errorf("expected parts %v, got %v", test.expected, e.Parts)
+		}
+	}
+
+	err := &UnrepresentableError{Parts: []string{"COUNT", "BYYEARDAY"}}
+	if err.Error() != "cron: cannot express COUNT, BYYEARDAY" {
+		t.Errorf("unexpected message %q", err.Error())
+	}
+}
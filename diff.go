@@ -0,0 +1,158 @@
+package rrule
+
+import (
+	"strings"
+	"time"
+)
+
+// Window is the range of time, from Start to End inclusive, over which Diff
+// compares occurrences.
+type Window struct {
+	Start, End time.Time
+}
+
+// SetDiff describes the changes from one set to another, e.g. to tell attendees
+// that an event moved from Tuesdays to Wednesdays and which occurrences that
+// removed and added.
+type SetDiff struct {
+	// Old and New are the compared sets, whose ToText describe each side.
+	Old, New *Set
+	// Changes are the parts of the sets that differ.
+	Changes []OptionChange
+	// Window is the range the occurrences were compared over.
+	Window Window
+	// Added are the occurrences of New in Window that are not in Old, and
+	// Removed those of Old that are not in New.
+	Added, Removed []time.Time
+}
+
+// OptionChange is a part of a set that differs between two sets.
+type OptionChange struct {
+	// Part is the name of the part: DTSTART, RRULE, RDATE, EXDATE or a part of
+	// the RRULE, e.g. "BYDAY".
+	Part string
+	// Old and New are the values of the part in RFC 5545 syntax, e.g. "TU" and
+	// "WE", or "" when the part is not given.
+	Old, New string
+}
+
+// Diff compares the sets oldSet and newSet: the parts of their RRULE, their DTSTART,
+// RDATE and EXDATE values, and their occurrences in window.
+//
+// The parts of the RRULE are compared with those implied by DTSTART made
+// explicit and lists sorted, so that FREQ=WEEKLY with a DTSTART on a Tuesday
+// and FREQ=WEEKLY;BYDAY=TU have the same BYDAY, and moving that DTSTART to a
+// Wednesday changes BYDAY from TU to WE.
+func Diff(oldSet, newSet *Set, window Window) SetDiff {
+	d := SetDiff{Old: oldSet, New: newSet, Window: window}
+	change := func(part, o, n string) {
+		if o != n {
+			d.Changes = append(d.Changes, OptionChange{Part: part, Old: o, New: n})
+		}
+	}
+
+	change("DTSTART", dtstartValue(oldSet.dtstart), dtstartValue(newSet.dtstart))
+
+	oldParts, newParts := rulePartValues(oldSet.rrule), rulePartValues(newSet.rrule)
+	if oldSet.rrule == nil || newSet.rrule == nil {
+		change("RRULE", strings.Join(oldParts, ";"), strings.Join(newParts, ";"))
+	} else {
+		for _, part := range []string{
+			"FREQ", "INTERVAL", "WKST", "COUNT", "UNTIL", "BYSETPOS", "BYMONTH", "BYMONTHDAY",
+			"BYYEARDAY", "BYWEEKNO", "BYDAY", "BYHOUR", "BYMINUTE", "BYSECOND", "BYEASTER",
+		} {
+			change(part, partValue(oldParts, part), partValue(newParts, part))
+		}
+	}
+
+	change("RDATE", datesValue(oldSet.rdate), datesValue(newSet.rdate))
+	change("EXDATE", datesValue(oldSet.exdate), datesValue(newSet.exdate))
+
+	oldTimes := oldSet.Between(window.Start, window.End, true)
+	newTimes := newSet.Between(window.Start, window.End, true)
+	for i, j := 0, 0; i < len(oldTimes) || j < len(newTimes); {
+		switch {
+		case j == len(newTimes) || i < len(oldTimes) && oldTimes[i].Before(newTimes[j]):
+			d.Removed = append(d.Removed, oldTimes[i])
+			i++
+		case i == len(oldTimes) || newTimes[j].Before(oldTimes[i]):
+			d.Added = append(d.Added, newTimes[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+
+	return d
+}
+
+// Changed reports whether the sets differ in a part or in their occurrences in
+// the window.
+func (d SetDiff) Changed() bool {
+	return len(d.Changes) != 0 || len(d.Added) != 0 || len(d.Removed) != 0
+}
+
+// Change returns the change of part and true, or false if part did not change.
+func (d SetDiff) Change(part string) (OptionChange, bool) {
+	for _, c := range d.Changes {
+		if c.Part == part {
+			return c, true
+		}
+	}
+
+	return OptionChange{}, false
+}
+
+// rulePartValues returns the NAME=VALUE parts of the rule with the parts implied
+// by DTSTART made explicit and lists sorted, or nil without rule.
+func rulePartValues(r *RRule) []string {
+	if r == nil {
+		return nil
+	}
+
+	option := r.Options
+	option.Dtstart = time.Time{}
+	option.Interval = r.interval
+	option.Bysetpos = uniqueInts(option.Bysetpos)
+	option.Bymonth = uniqueInts(option.Bymonth)
+	option.Bymonthday = uniqueInts(option.Bymonthday)
+	option.Byyearday = uniqueInts(option.Byyearday)
+	option.Byweekno = uniqueInts(option.Byweekno)
+	option.Byweekday = uniqueWeekdays(option.Byweekday)
+	option.Byhour = uniqueInts(r.byhour)
+	option.Byminute = uniqueInts(r.byminute)
+	option.Bysecond = uniqueInts(r.bysecond)
+	option.Byeaster = uniqueInts(option.Byeaster)
+
+	return strings.Split(option.RRuleString(), ";")
+}
+
+// partValue returns the value of part in parts, or "" if it is not given.
+func partValue(parts []string, part string) string {
+	for _, p := range parts {
+		if strings.HasPrefix(p, part+"=") {
+			return p[len(part)+1:]
+		}
+	}
+
+	return ""
+}
+
+func dtstartValue(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return strings.TrimPrefix(timeToRFCDatetimeStr(t), ":")
+}
+
+// datesValue returns the distinct instants of dates in UTC, comma separated.
+func datesValue(dates []time.Time) string {
+	values := make([]string, 0, len(dates))
+	for _, t := range uniqueTimes(dates) {
+		values = append(values, timeToStr(t))
+	}
+
+	return strings.Join(values, ",")
+}
@@ -0,0 +1,70 @@
+package rrule
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDiff(t *testing.T) {
+	oldSet, _ := StrToRRuleSet("DTSTART:20240102T090000Z\nRRULE:FREQ=WEEKLY;COUNT=10\nEXDATE:20240109T090000Z")
+	newSet, _ := StrToRRuleSet("DTSTART:20240103T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=WE;COUNT=10;BYHOUR=9")
+	window := Window{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 17, 0, 0, 0, 0, time.UTC)}
+
+	d := Diff(oldSet, newSet, window)
+	if !d.Changed() {
+		t.Fatal("expected a change")
+	}
+
+	expected := []OptionChange{
+		{Part: "DTSTART", Old: "20240102T090000Z", New: "20240103T090000Z"},
+		{Part: "BYDAY", Old: "TU", New: "WE"},
+		{Part: "EXDATE", Old: "20240109T090000Z", New: ""},
+	}
+	if !reflect.DeepEqual(d.Changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, d.Changes)
+	}
+	if c, ok := d.Change("BYDAY"); !ok || c.New != "WE" {
+		t.Errorf("expected a BYDAY change to WE, got %v, %v", c, ok)
+	}
+	if _, ok := d.Change("BYHOUR"); ok {
+		t.Error("expected no BYHOUR change, it is implied by DTSTART")
+	}
+
+	added := []time.Time{
+		time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC),
+	}
+	removed := []time.Time{
+		time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+	}
+	if !timesEqual(d.Added, added) {
+		t.Errorf("expected added %v, got %v", added, d.Added)
+	}
+	if !timesEqual(d.Removed, removed) {
+		t.Errorf("expected removed %v, got %v", removed, d.Removed)
+	}
+}
+
+func TestDiffRRule(t *testing.T) {
+	oldSet, _ := StrToRRuleSet("DTSTART:20240102T090000Z\nRRULE:FREQ=DAILY;COUNT=3")
+	newSet, _ := StrToRRuleSet("DTSTART:20240102T090000Z\nRDATE:20240102T090000Z")
+	window := Window{time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)}
+
+	d := Diff(oldSet, newSet, window)
+	expected := []OptionChange{
+		{Part: "RRULE", Old: "FREQ=DAILY;INTERVAL=1;COUNT=3;BYHOUR=9;BYMINUTE=0;BYSECOND=0", New: ""},
+		{Part: "RDATE", Old: "", New: "20240102T090000Z"},
+	}
+	if !reflect.DeepEqual(d.Changes, expected) {
+		t.Errorf("expected changes %v, got %v", expected, d.Changes)
+	}
+	if len(d.Added) != 0 || len(d.Removed) != 2 {
+		t.Errorf("expected 2 removed occurrences, got %v added and %v removed", d.Added, d.Removed)
+	}
+
+	if d := Diff(oldSet, oldSet, window); d.Changed() {
+		t.Errorf("expected no change, got %+v", d)
+	}
+}
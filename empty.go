@@ -0,0 +1,171 @@
+package rrule
+
+import (
+	"errors"
+	"time"
+)
+
+// ErrNoOccurrences is returned by RRule.Err when generating occurrences was aborted
+// because the rule went through a whole cycle of the Gregorian calendar without
+// producing one, so it cannot produce any further occurrence.
+var ErrNoOccurrences = errors.New("rrule: no occurrence in 400 years of periods")
+
+// gregorianCycle is the number of periods of each frequency in the 400 years after
+// which the Gregorian calendar repeats. Past one cycle, the BYxxx rules of a
+// period match the days they matched one cycle earlier, so a rule iterating
+// through that many periods in a row without occurrence stays empty. Rules finer
+// than daily skip an excluded day in one period, so they are bounded by the days
+// of a cycle.
+var gregorianCycle = [...]int{
+	YEARLY:   400,
+	MONTHLY:  400 * 12,
+	WEEKLY:   146097 / 7,
+	DAILY:    146097,
+	HOURLY:   146097,
+	MINUTELY: 146097,
+	SECONDLY: 146097,
+}
+
+// Satisfiable reports whether a rule built from option can produce an occurrence.
+// It is false for options out of the bounds of RFC 5545, see IsEmpty for the others.
+func (option ROption) Satisfiable() bool {
+	if validateBounds(option) != nil {
+		return false
+	}
+
+	r := buildRRule(option)
+	return !r.IsEmpty()
+}
+
+// IsEmpty reports whether the rule never produces an occurrence, e.g.
+// FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30, without generating them.
+//
+// The BYxxx rules are checked against the periods of one cycle of the Gregorian
+// calendar starting at DTSTART, which decides whether any day matches, and the
+// times of day against the hours, minutes or seconds reachable with INTERVAL.
+// BYSETPOS is checked against the occurrences of a period, that is the times of
+// one hour, minute or second for rules finer than daily.
+// Rules with BYEASTER, whose dates do not repeat with the calendar, are only
+// reported empty when no day matches up to an UNTIL within the cycle.
+func (r *RRule) IsEmpty() bool {
+	if r.until.Before(r.dtstart) || !r.timeReachable() {
+		return true
+	}
+
+	return !r.dayReachable()
+}
+
+// timeReachable reports whether the times of day stepped through by a rule finer
+// than daily reach BYHOUR, BYMINUTE and BYSECOND.
+func (r *RRule) timeReachable() bool {
+	var unit, steps int
+	switch r.freq {
+	case HOURLY:
+		unit, steps = 3600, 24
+	case MINUTELY:
+		unit, steps = 60, 1440
+	case SECONDLY:
+		unit, steps = 1, 86400
+	default:
+		return true
+	}
+
+	hour, minute, second := r.dtstart.Clock()
+	start := hour*3600 + minute*60 + second
+	step := r.interval % steps * unit
+	for k := 0; k < steps; k++ {
+		tod := (start + k*step) % 86400
+		if len(r.byhour) != 0 && !contains(r.byhour, tod/3600) ||
+			r.freq >= MINUTELY && len(r.byminute) != 0 && !contains(r.byminute, tod/60%60) ||
+			r.freq >= SECONDLY && len(r.bysecond) != 0 && !contains(r.bysecond, tod%60) {
+			continue
+		}
+
+		return true
+	}
+
+	return false
+}
+
+// dayReachable reports whether a day of the periods of one Gregorian cycle from
+// DTSTART, up to UNTIL, passes the BYxxx rules. Rules finer than daily may
+// reach any day.
+func (r *RRule) dayReachable() bool {
+	info := iterInfo{rrule: r}
+	year, month, day := r.dtstart.Date()
+	first := time.Date(year, month, day, 0, 0, 0, 0, r.dtstart.Location())
+	weekday := toPyWeekday(r.dtstart.Weekday())
+
+	// One more period than a cycle, since the first one starts at DTSTART.
+	for period := 0; period <= gregorianCycle[r.freq] && year <= MAXYEAR; period++ {
+		info.rebuild(year, month)
+		start, end := info.calcDaySet(r.freq, year, month, day)
+
+		// BYSETPOS counts the days of the whole period, matched the days from DTSTART.
+		defined, matched := 0, 0
+		for i := start; i < end; i++ {
+			if info.excluded(i) {
+				continue
+			}
+
+			date := info.firstyday.AddDate(0, 0, i)
+			if date.After(r.until) {
+				return matched > 0
+			}
+
+			defined++
+			if !date.Before(first) {
+				matched++
+			}
+		}
+
+		if matched > 0 && r.setPosReachable(defined) {
+			return true
+		}
+
+		switch r.freq {
+		case YEARLY:
+			year += r.interval
+		case MONTHLY:
+			year, month, day = time.Date(year, month+time.Month(r.interval), 1, 0, 0, 0, 0, time.UTC).Date()
+		case WEEKLY:
+			// Periods after the first one start on WKST.
+			year, month, day = time.Date(year, month, day-pymod(weekday-r.wkst, 7)+r.interval*7, 0, 0, 0, 0, time.UTC).Date()
+			weekday = r.wkst
+		case DAILY:
+			year, month, day = time.Date(year, month, day+r.interval, 0, 0, 0, 0, time.UTC).Date()
+		default:
+			year, month, day = time.Date(year, month, day+1, 0, 0, 0, 0, time.UTC).Date()
+		}
+	}
+
+	// Easter does not follow the Gregorian cycle, so a later period may still match.
+	return len(r.byeaster) != 0 && year <= MAXYEAR
+}
+
+// setPosReachable reports whether BYSETPOS selects one of the occurrences of a
+// period with days matching days.
+func (r *RRule) setPosReachable(days int) bool {
+	if len(r.bysetpos) == 0 {
+		return true
+	}
+
+	// The period of a rule finer than daily has the times of one hour, minute
+	// or second of its day.
+	n := days * len(r.timeset)
+	switch r.freq {
+	case HOURLY:
+		n = days * len(r.byminute) * len(r.bysecond)
+	case MINUTELY:
+		n = days * len(r.bysecond)
+	case SECONDLY:
+		n = days
+	}
+	for _, pos := range r.bysetpos {
+		if 0 < pos && pos <= n || pos < 0 && -pos <= n {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,121 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIsEmpty(t *testing.T) {
+	var tests = []struct {
+		rule  string
+		empty bool
+	}{
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29", false},
+		{"DTSTART:20010101T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=4;BYMONTH=2;BYMONTHDAY=29", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=29;BYDAY=MO", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYWEEKNO=53;BYDAY=MO", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=1;BYMONTHDAY=1;BYSETPOS=2", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYEASTER=0", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=MONTHLY;BYMONTH=4,6,9,11;BYMONTHDAY=31", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=MONTHLY;BYMONTH=4,6,9,11;BYMONTHDAY=-30", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=5FR;BYMONTH=2", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=FR;BYMONTHDAY=13", false},
+		{"DTSTART:20000103T090000Z\nRRULE:FREQ=DAILY;INTERVAL=7;BYDAY=TU", true},
+		{"DTSTART:20000103T090000Z\nRRULE:FREQ=DAILY;INTERVAL=7;BYDAY=MO", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=24;BYHOUR=3", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=5;BYHOUR=3", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=MINUTELY;INTERVAL=30;BYMINUTE=15", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=HOURLY;BYMONTH=2;BYMONTHDAY=30", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=MINUTELY;BYSETPOS=2", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=MINUTELY;BYSECOND=0,30;BYSETPOS=2", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=HOURLY;BYMINUTE=0,30;BYSETPOS=-2", false},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=DAILY;UNTIL=19990101T000000Z", true},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=3;UNTIL=20000201T000000Z", true},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		if got := r.IsEmpty(); got != tt.empty {
+			t.Errorf("%q: expected IsEmpty %v, got %v", tt.rule, tt.empty, got)
+		}
+		if got := r.Options.Satisfiable(); got == tt.empty {
+			t.Errorf("%q: expected Satisfiable %v, got %v", tt.rule, !tt.empty, got)
+		}
+	}
+}
+
+func TestSatisfiableOutOfBounds(t *testing.T) {
+	option := ROption{Freq: MONTHLY, Bymonthday: []int{32}}
+	if option.Satisfiable() {
+		t.Errorf("expected %v not to be satisfiable", option.Bymonthday)
+	}
+}
+
+func TestEmptyGenerationAborts(t *testing.T) {
+	for _, rule := range []string{
+		"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+		"DTSTART:20000103T090000Z\nRRULE:FREQ=DAILY;INTERVAL=7;BYDAY=TU",
+		"DTSTART:20000101T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=24;BYHOUR=3",
+		"DTSTART:20000101T090000Z\nRRULE:FREQ=MINUTELY;BYSETPOS=2",
+	} {
+		r, err := StrToRRule(rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", rule, err)
+		}
+
+		if got := r.All(); len(got) != 0 {
+			t.Errorf("%q: expected no occurrences, got %v", rule, got)
+		}
+		if err := r.Err(); err != ErrNoOccurrences {
+			t.Errorf("%q: expected ErrNoOccurrences, got %v", rule, err)
+		}
+	}
+}
+
+func TestLongGapDoesNotAbort(t *testing.T) {
+	// Leap days on a Monday are 28 or 40 years apart.
+	r, _ := NewRRule(ROption{
+		Freq:       YEARLY,
+		Count:      3,
+		Bymonth:    []int{2},
+		Bymonthday: []int{29},
+		Byweekday:  []Weekday{MO},
+		Dtstart:    time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC),
+	})
+
+	want := []time.Time{
+		time.Date(2016, 2, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2044, 2, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2072, 2, 29, 9, 0, 0, 0, time.UTC),
+	}
+	if got := r.All(); !timesEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if err := r.Err(); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestErrConcurrent(t *testing.T) {
+	r, _ := StrToRRule("DTSTART:20000101T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=24;BYHOUR=3")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		r.All()
+	}()
+	for i := 0; i < 100; i++ {
+		r.Err()
+		r.Count()
+	}
+	<-done
+
+	if err := r.Err(); err != ErrNoOccurrences {
+		t.Errorf("expected ErrNoOccurrences, got %v", err)
+	}
+}
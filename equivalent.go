@@ -0,0 +1,232 @@
+package rrule
+
+import (
+	"sort"
+	"time"
+)
+
+// EquivalenceHorizon is the number of occurrences Equivalent and EquivalentSets
+// compare when they cannot decide analytically whether two infinite rules or sets
+// are equivalent. Rules agreeing on their first EquivalenceHorizon occurrences
+// are reported equivalent.
+const EquivalenceHorizon = 100000
+
+// Equivalent reports whether a and b generate the same occurrences, e.g.
+// FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR and FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR with the
+// same DTSTART. Occurrences are compared as instants.
+//
+// Rules whose canonical options are equal, and rules that are both empty, are
+// equivalent. Finite rules are compared occurrence by occurrence, and a finite
+// rule is never equivalent to an infinite one. Other rules are compared up to
+// EquivalenceHorizon occurrences.
+func Equivalent(a, b *RRule) bool {
+	if equalOptions(a.canonical(), b.canonical()) {
+		return true
+	}
+
+	if a.IsFinite() != b.IsFinite() {
+		return false
+	}
+	if a.IsFinite() {
+		return a.Count() == b.Count() && sameOccurrences(a.Iterator(), b.Iterator(), -1)
+	}
+	if a.IsEmpty() && b.IsEmpty() {
+		return true
+	}
+
+	return sameOccurrences(a.Iterator(), b.Iterator(), EquivalenceHorizon)
+}
+
+// EquivalentSets reports whether a and b generate the same occurrences.
+//
+// Sets with canonically equal rules and the same RDATE and EXDATE values are
+// equivalent. Otherwise finite sets are compared occurrence by occurrence,
+// a finite set is never equivalent to an infinite one, and infinite sets are
+// compared up to EquivalenceHorizon occurrences.
+func EquivalentSets(a, b *Set) bool {
+	sameRule := a.rrule == nil && b.rrule == nil ||
+		a.rrule != nil && b.rrule != nil && equalOptions(a.rrule.canonical(), b.rrule.canonical())
+	if sameRule && equalTimes(uniqueTimes(a.rdate), uniqueTimes(b.rdate)) &&
+		equalTimes(uniqueTimes(a.exdate), uniqueTimes(b.exdate)) {
+		return true
+	}
+
+	if a.IsFinite() != b.IsFinite() {
+		return false
+	}
+	if a.IsFinite() {
+		return sameOccurrences(a.Iterator(), b.Iterator(), -1)
+	}
+
+	return sameOccurrences(a.Iterator(), b.Iterator(), EquivalenceHorizon)
+}
+
+// canonical returns the options of the rule with its defaults made explicit and
+// the parts that do not change its occurrences removed, so that rules with equal
+// canonical options are equivalent.
+//
+// Rules of daily or coarser frequency with an interval of 1 and without BYSETPOS
+// or nth weekdays only filter days, so they are all expressed as daily rules.
+func (r *RRule) canonical() ROption {
+	option := ROption{
+		Freq:       r.freq,
+		Dtstart:    r.dtstart,
+		Interval:   r.interval,
+		Count:      r.count,
+		Until:      r.Options.Until,
+		Bysetpos:   uniqueInts(r.bysetpos),
+		Bymonth:    uniqueInts(r.bymonth),
+		Bymonthday: uniqueInts(append(append([]int(nil), r.bymonthday...), r.bynmonthday...)),
+		Byyearday:  uniqueInts(r.byyearday),
+		Byweekno:   uniqueInts(r.byweekno),
+		Byhour:     uniqueInts(r.byhour),
+		Byminute:   uniqueInts(r.byminute),
+		Bysecond:   uniqueInts(r.bysecond),
+		Byeaster:   uniqueInts(r.byeaster),
+	}
+
+	for _, wday := range r.byweekday {
+		option.Byweekday = append(option.Byweekday, Weekday{weekday: wday})
+	}
+	option.Byweekday = append(option.Byweekday, r.bynweekday...)
+	option.Byweekday = uniqueWeekdays(option.Byweekday)
+
+	if len(option.Bymonth) == 12 {
+		option.Bymonth = nil
+	}
+	if len(option.Byweekday) == 7 && len(r.bynweekday) == 0 {
+		option.Byweekday = nil
+	}
+
+	if option.Freq <= DAILY && option.Interval == 1 && len(option.Bysetpos) == 0 && len(r.bynweekday) == 0 {
+		option.Freq = DAILY
+	}
+
+	// WKST numbers the weeks and starts the periods of weekly rules, which only
+	// matters when weeks are skipped or BYSETPOS selects within them.
+	if len(option.Byweekno) != 0 ||
+		option.Freq == WEEKLY && (option.Interval > 1 || len(option.Bysetpos) != 0) {
+		option.Wkst = Weekday{weekday: r.wkst}
+	}
+
+	return option
+}
+
+// equalOptions reports whether a and b are the same canonical options.
+func equalOptions(a, b ROption) bool {
+	return a.Freq == b.Freq &&
+		a.Dtstart.Equal(b.Dtstart) && a.Dtstart.Location().String() == b.Dtstart.Location().String() &&
+		a.Interval == b.Interval &&
+		a.Wkst == b.Wkst &&
+		a.Count == b.Count &&
+		a.Until.Equal(b.Until) &&
+		equalInts(a.Bysetpos, b.Bysetpos) &&
+		equalInts(a.Bymonth, b.Bymonth) &&
+		equalInts(a.Bymonthday, b.Bymonthday) &&
+		equalInts(a.Byyearday, b.Byyearday) &&
+		equalInts(a.Byweekno, b.Byweekno) &&
+		equalWeekdays(a.Byweekday, b.Byweekday) &&
+		equalInts(a.Byhour, b.Byhour) &&
+		equalInts(a.Byminute, b.Byminute) &&
+		equalInts(a.Bysecond, b.Bysecond) &&
+		equalInts(a.Byeaster, b.Byeaster)
+}
+
+// sameOccurrences reports whether a and b generate the same instants, comparing
+// at most limit occurrences unless limit is negative.
+func sameOccurrences(a, b Next, limit int) bool {
+	for i := 0; limit < 0 || i < limit; i++ {
+		x, okA := a()
+		y, okB := b()
+		if okA != okB || !x.Equal(y) {
+			return false
+		}
+		if !okA {
+			return true
+		}
+	}
+
+	return true
+}
+
+// uniqueInts returns the sorted distinct values of list, or nil if it is empty.
+func uniqueInts(list []int) []int {
+	var unique []int
+	for _, v := range list {
+		if !contains(unique, v) {
+			unique = append(unique, v)
+		}
+	}
+	sort.Ints(unique)
+
+	return unique
+}
+
+// uniqueWeekdays returns the distinct weekdays of list sorted by weekday, then by n.
+func uniqueWeekdays(list []Weekday) []Weekday {
+	var unique []Weekday
+	for _, w := range list {
+		if indexOfWeekDay(unique, w) == -1 {
+			unique = append(unique, w)
+		}
+	}
+	sort.Slice(unique, func(i, j int) bool {
+		if unique[i].weekday != unique[j].weekday {
+			return unique[i].weekday < unique[j].weekday
+		}
+		return unique[i].n < unique[j].n
+	})
+
+	return unique
+}
+
+// uniqueTimes returns the sorted distinct instants of list.
+func uniqueTimes(list []time.Time) []time.Time {
+	var unique []time.Time
+	for _, t := range sortedTimes(list) {
+		if len(unique) == 0 || !unique[len(unique)-1].Equal(t) {
+			unique = append(unique, t)
+		}
+	}
+
+	return unique
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalWeekdays(a, b []Weekday) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func equalTimes(a, b []time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,106 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEquivalent(t *testing.T) {
+	var tests = []struct {
+		a, b       string
+		equivalent bool
+	}{
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;BYDAY=FR,TH,WE,TU,MO",
+			true,
+		},
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=MONTHLY;BYMONTH=9;BYMONTHDAY=2",
+			true,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;BYMONTH=1,2,3,4,5,6,7,8,9,10,11,12",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR,SA,SU",
+			true,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=10",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;UNTIL=20240110T090000Z",
+			true,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=10",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=11",
+			false,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=5",
+			false,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO;WKST=SU",
+			true,
+		},
+		{
+			"DTSTART:19970805T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU;WKST=MO",
+			"DTSTART:19970805T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,SU;WKST=SU",
+			false,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;BYMONTH=4;BYMONTHDAY=31",
+			true,
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY",
+			"DTSTART:20240101T100000Z\nRRULE:FREQ=DAILY",
+			false,
+		},
+	}
+
+	for _, tt := range tests {
+		a, err := StrToRRule(tt.a)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.a, err)
+		}
+		b, err := StrToRRule(tt.b)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.b, err)
+		}
+
+		if got := Equivalent(a, b); got != tt.equivalent {
+			t.Errorf("%q, %q: expected %v, got %v", tt.a, tt.b, tt.equivalent, got)
+		}
+		if got := Equivalent(b, a); got != tt.equivalent {
+			t.Errorf("%q, %q: expected %v, got %v", tt.b, tt.a, tt.equivalent, got)
+		}
+	}
+}
+
+func TestEquivalentSets(t *testing.T) {
+	a, _ := StrToRRuleSet("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=3\nEXDATE:20240102T090000Z")
+	b, _ := StrToRRuleSet("RDATE:20240103T090000Z,20240101T090000Z")
+	if !EquivalentSets(a, b) {
+		t.Errorf("expected %v and %v to be equivalent", a.All(), b.All())
+	}
+
+	b.RDate(time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC))
+	if EquivalentSets(a, b) {
+		t.Errorf("expected %v and %v to differ", a.All(), b.All())
+	}
+
+	a, _ = StrToRRuleSet("DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR\nEXDATE:20240102T090000Z,20240101T090000Z")
+	b, _ = StrToRRuleSet("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR\nEXDATE:20240101T090000Z,20240102T090000Z")
+	if !EquivalentSets(a, b) {
+		t.Errorf("expected %q and %q to be equivalent", a.String(), b.String())
+	}
+
+	b.RDate(time.Date(2024, 1, 6, 9, 0, 0, 0, time.UTC))
+	if EquivalentSets(a, b) {
+		t.Errorf("expected %q and %q to differ", a.String(), b.String())
+	}
+}
@@ -207,7 +207,7 @@ func ExampleStrToRRuleSet() {
 	// 2006-01-05 15:04:05 +0000 UTC
 }
 
-func ExampleToText() {
+func ExampleRRule_ToText() {
 	r, _ := rrule.StrToRRule("FREQ=DAILY;COUNT=5")
 	fmt.Println(r.String())
 	// RRULE:FREQ=DAILY;COUNT=5
@@ -220,6 +220,51 @@ func ExampleToText() {
 	// every day for 5 times
 }
 
+func ExampleSet_ToText() {
+	s, _ := rrule.StrToRRuleSet("DTSTART:20231204T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO\nEXDATE:20231225T090000Z\nRDATE:20240102T090000Z")
+	fmt.Println(s.ToText())
+	// every week on Monday at 9:00 AM, except December 25, 2023, plus January 2, 2024
+
+	// Output:
+	// every week on Monday at 9:00 AM, except December 25, 2023, plus January 2, 2024
+}
+
+func ExampleRRule_ToTextWithOptions() {
+	r, _ := rrule.StrToRRule("DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10")
+
+	for _, style := range []rrule.TextStyle{rrule.TextCompact, rrule.TextStandard, rrule.TextVerbose} {
+		got, err := r.ToTextWithOptions(rrule.TextOptions{Style: style})
+		if err != nil {
+			panic(err)
+		}
+
+		fmt.Println(got)
+	}
+	// Weekly on Mon, Wed at 9:00 AM, 10 times
+	// every week on Monday, Wednesday at 9:00 AM for 10 times
+	// Every week on Monday and Wednesday at 9:00 AM, starting September 2, 1997, 10 times
+
+	// Output:
+	// Weekly on Mon, Wed at 9:00 AM, 10 times
+	// every week on Monday, Wednesday at 9:00 AM for 10 times
+	// Every week on Monday and Wednesday at 9:00 AM, starting September 2, 1997, 10 times
+}
+
+func ExampleRRule_ToTextIn() {
+	r, _ := rrule.StrToRRule("FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30;COUNT=10")
+
+	got, err := r.ToTextIn(language.German)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(got)
+	// jeden Tag um 09:30 und 17:30, 10 Mal
+
+	// Output:
+	// jeden Tag um 09:30 und 17:30, 10 Mal
+}
+
 type indonesianFormatter struct{}
 
 // Format implements TimeFormatter.
@@ -285,9 +330,17 @@ func (i indonesianFormatter) WeekDayName(w rrule.Weekday) string {
 	return weekday
 }
 
-var _ rrule.TimeFormatter = indonesianFormatter{}
+// Clock implements ClockFormatter.
+func (indonesianFormatter) Clock(hour, minute, second int) string {
+	return fmt.Sprintf("%02d.%02d", hour, minute)
+}
+
+var (
+	_ rrule.TimeFormatter  = indonesianFormatter{}
+	_ rrule.ClockFormatter = indonesianFormatter{}
+)
 
-func ExampleToTextWithCustomFormatter() {
+func ExampleRRule_ToTextWithCustomFormatter() {
 	bun := i18n.NewBundle(language.English)
 	bun.RegisterUnmarshalFunc("toml", toml.Unmarshal)
 
@@ -304,9 +357,19 @@ func ExampleToTextWithCustomFormatter() {
 	}
 
 	fmt.Println(got)
-	// Every day for 5 times
+	// setiap hari sebanyak 5 kali
+
+	r, _ = rrule.StrToRRuleWithi18n("FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30", bun)
+	got, err = r.ToTextWithCustomFormatter(indonesianFormatter{}, "id")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(got)
+	// setiap hari pada pukul 09.30 dan 17.30
 
 	// Output:
 	// FREQ=DAILY;COUNT=5
 	// setiap hari sebanyak 5 kali
+	// setiap hari pada pukul 09.30 dan 17.30
 }
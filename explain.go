@@ -0,0 +1,339 @@
+package rrule
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Explanation tells why a time is or is not an occurrence of a rule or a set.
+type Explanation struct {
+	Time time.Time
+	// Occurrence reports whether Time is an occurrence, as by Contains.
+	Occurrence bool
+	// Steps are the parts of the rule or set Time was checked against, in the
+	// order the iteration applies them.
+	Steps []ExplanationStep
+}
+
+// ExplanationStep is the check of a time against one part of a rule or set.
+type ExplanationStep struct {
+	// Part is the name of the part, e.g. "BYMONTHDAY", "INTERVAL" or "EXDATE".
+	Part string
+	// Implied reports whether the part was not given but derived from DTSTART,
+	// e.g. the BYMONTHDAY of a monthly rule.
+	Implied bool
+	// Accepted reports whether the part lets the time through.
+	Accepted bool
+	// Detail describes the check, e.g. "day 29 of the month is not in 31".
+	Detail string
+}
+
+// Rejected returns the steps that did not accept the time.
+func (e Explanation) Rejected() []ExplanationStep {
+	var rejected []ExplanationStep
+	for _, step := range e.Steps {
+		if !step.Accepted {
+			rejected = append(rejected, step)
+		}
+	}
+
+	return rejected
+}
+
+// String returns the explanation on several lines, one per step.
+func (e Explanation) String() string {
+	lines := []string{fmt.Sprintf("%v is an occurrence", e.Time)}
+	if !e.Occurrence {
+		lines[0] = fmt.Sprintf("%v is not an occurrence", e.Time)
+	}
+
+	for _, step := range e.Steps {
+		verdict := "accepted"
+		if !step.Accepted {
+			verdict = "rejected"
+		}
+		line := fmt.Sprintf("  %s %s: %s", step.Part, verdict, step.Detail)
+		if step.Implied {
+			line += " (from DTSTART)"
+		}
+		lines = append(lines, line)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Explain tells why t is or is not an occurrence of the rule: it checks t against
+// DTSTART, UNTIL, the INTERVAL alignment of its period, the BYxxx parts, in the
+// order the iteration applies them, and COUNT.
+//
+// BYSETPOS and COUNT are only checked when every other part accepts t. COUNT is
+// checked by looking up the index of t, as by IndexOf.
+func (r *RRule) Explain(t time.Time) Explanation {
+	e := Explanation{Time: t}
+	step := func(part string, implied, accepted bool, format string, args ...interface{}) {
+		e.Steps = append(e.Steps, ExplanationStep{
+			Part: part, Implied: implied, Accepted: accepted, Detail: fmt.Sprintf(format, args...),
+		})
+	}
+
+	if t.Before(r.dtstart) {
+		step("DTSTART", false, false, "%v is before DTSTART %v", t, r.dtstart)
+	} else {
+		step("DTSTART", false, true, "%v is not before DTSTART %v", t, r.dtstart)
+	}
+	if !r.OrigOptions.Until.IsZero() {
+		if t.After(r.until) {
+			step("UNTIL", false, false, "%v is after UNTIL %v", t, r.until)
+		} else {
+			step("UNTIL", false, true, "%v is not after UNTIL %v", t, r.until)
+		}
+	}
+
+	lt := t.In(r.dtstart.Location())
+	year, month, day := lt.Date()
+	date := time.Date(year, month, day, 0, 0, 0, 0, lt.Location())
+	if r.freq < HOURLY {
+		year, month, _, aligned := r.period(lt)
+		if r.interval > 1 {
+			step("INTERVAL", false, aligned, "the %s period of %v is %s by INTERVAL=%d",
+				strings.ToLower(r.freq.String()), date.Format("2006-01-02"), skippedOrNot(aligned), r.interval)
+		}
+		r.explainDay(step, year, month, dayNumber(lt))
+		r.explainTime(step, lt, int(t.Sub(date)/time.Second))
+	} else {
+		// An occurrence is a duration from the midnight of its day, which a
+		// daylight saving time transition may move to the next day, so t is
+		// checked from the previous midnight too, as by Contains. The steps of
+		// the day of t are kept when neither midnight accepts it.
+		base := e.Steps
+		var first []ExplanationStep
+		for i, date := range []time.Time{date, time.Date(year, month, day-1, 0, 0, 0, 0, lt.Location())} {
+			elapsed := t.Sub(date)
+			if i > 0 && (elapsed < 0 || elapsed >= 24*time.Hour) {
+				continue
+			}
+
+			e.Steps = base[:len(base):len(base)]
+			seconds := int(elapsed / time.Second)
+			if r.interval > 1 {
+				aligned := r.alignedTime(date, seconds)
+				step("INTERVAL", false, aligned, "%v is %s by INTERVAL=%d from DTSTART",
+					lt.Format("15:04:05"), skippedOrNot(aligned), r.interval)
+			}
+			r.explainDay(step, date.Year(), date.Month(), dayNumber(date))
+			r.explainTime(step, lt, seconds)
+			if allAccepted(e.Steps) {
+				first = nil
+				break
+			}
+			if first == nil {
+				first = e.Steps
+			}
+		}
+		if first != nil {
+			e.Steps = first
+		}
+	}
+
+	if !allAccepted(e.Steps) {
+		return e
+	}
+
+	if len(r.bysetpos) != 0 {
+		selected := r.matches(t)
+		step("BYSETPOS", false, selected, "the position of %v in its %s period is %s by BYSETPOS=%s",
+			t, strings.ToLower(r.freq.String()), selectedOrNot(selected), joinInts(r.bysetpos))
+		if !selected {
+			return e
+		}
+	}
+
+	if r.count > 0 {
+		i, _ := r.index(t)
+		if i < r.count {
+			step("COUNT", false, true, "%v is occurrence %d of COUNT=%d", t, i+1, r.count)
+		} else {
+			step("COUNT", false, false, "%v would be occurrence %d, past COUNT=%d", t, i+1, r.count)
+			return e
+		}
+	}
+
+	e.Occurrence = true
+	return e
+}
+
+// Explain tells why t is or is not an occurrence of the set: the steps of
+// RRule.Explain for its RRULE, whether t is an RDATE value and whether an
+// EXDATE value removes it.
+func (set *Set) Explain(t time.Time) Explanation {
+	e := Explanation{Time: t}
+	if set.rrule != nil {
+		e = set.rrule.Explain(t)
+	}
+
+	for _, rdate := range set.rdate {
+		if rdate.Equal(t) {
+			e.Occurrence = true
+			e.Steps = append(e.Steps, ExplanationStep{Part: "RDATE", Accepted: true, Detail: fmt.Sprintf("%v is an RDATE value", t)})
+			break
+		}
+	}
+
+	if len(set.exdate) != 0 {
+		step := ExplanationStep{Part: "EXDATE", Accepted: true, Detail: fmt.Sprintf("%v is not an EXDATE value", t)}
+		for _, exdate := range set.exdate {
+			if exdate.Equal(t) {
+				e.Occurrence = false
+				step.Accepted = false
+				step.Detail = fmt.Sprintf("%v is removed by an EXDATE value", t)
+				break
+			}
+		}
+		e.Steps = append(e.Steps, step)
+	}
+
+	return e
+}
+
+// explainDay adds the steps of the BYxxx day parts for the day n of the period
+// starting in year and month, in the order of iterInfo.excluded.
+func (r *RRule) explainDay(step func(string, bool, bool, string, ...interface{}), year int, month time.Month, n int) {
+	info := iterInfo{rrule: r}
+	info.rebuild(year, month)
+	i := n - dayNumber(info.firstyday)
+	yfrom, mfrom, dfrom := fromDayNumber(n)
+	day := time.Date(yfrom, mfrom, dfrom, 0, 0, 0, 0, time.UTC)
+
+	if len(r.bymonth) != 0 {
+		ok := contains(r.bymonth, info.mmask[i])
+		step("BYMONTH", len(r.OrigOptions.Bymonth) == 0, ok, "month %d %s %s", info.mmask[i], inOrNot(ok), joinInts(r.bymonth))
+	}
+	if len(r.byweekno) != 0 {
+		ok := info.wnomask[i] != 0
+		step("BYWEEKNO", false, ok, "the week of %v %s %s", day.Format("2006-01-02"), isOrNot(ok), joinInts(r.byweekno))
+	}
+	if len(r.byweekday) != 0 || len(r.bynweekday) != 0 {
+		ok := (len(r.byweekday) == 0 || contains(r.byweekday, info.wdaymask[i])) &&
+			(len(info.nwdaymask) == 0 || info.nwdaymask[i] != 0)
+		step("BYDAY", len(r.OrigOptions.Byweekday) == 0, ok, "%v, a %v, %s %s",
+			day.Format("2006-01-02"), day.Weekday(), matchesOrNot(ok), joinWeekdays(r.Options.Byweekday))
+	}
+	if len(r.byeaster) != 0 {
+		ok := info.eastermask[i] != 0
+		step("BYEASTER", false, ok, "%v %s %s days from Easter", day.Format("2006-01-02"), isOrNot(ok), joinInts(r.byeaster))
+	}
+	if len(r.bymonthday) != 0 || len(r.bynmonthday) != 0 {
+		ok := contains(r.bymonthday, info.mdaymask[i]) || contains(r.bynmonthday, info.nmdaymask[i])
+		step("BYMONTHDAY", len(r.OrigOptions.Bymonthday) == 0, ok, "day %d (%d) of the month %s %s",
+			info.mdaymask[i], info.nmdaymask[i], inOrNot(ok), joinInts(r.Options.Bymonthday))
+	}
+	if len(r.byyearday) != 0 {
+		yday, nyday := i+1, -info.yearlen+i
+		if i >= info.yearlen {
+			yday, nyday = i+1-info.yearlen, -info.nextyearlen+i-info.yearlen
+		}
+		ok := contains(r.byyearday, yday) || contains(r.byyearday, nyday)
+		step("BYYEARDAY", false, ok, "day %d (%d) of the year %s %s", yday, nyday, inOrNot(ok), joinInts(r.byyearday))
+	}
+}
+
+// explainTime adds the steps of BYHOUR, BYMINUTE and BYSECOND for lt, seconds
+// from the midnight of its day.
+func (r *RRule) explainTime(step func(string, bool, bool, string, ...interface{}), lt time.Time, seconds int) {
+	if lt.Nanosecond() != 0 {
+		step("BYSECOND", false, false, "%v has a fraction of a second", lt)
+		return
+	}
+
+	hour, minute, second := seconds/3600, seconds/60%60, seconds%60
+	if r.freq < HOURLY {
+		// A time of day skipped by a daylight saving time transition is moved
+		// by the iteration as it is by time.Date.
+		hour, minute, second = lt.Clock()
+		for _, tm := range r.timeset {
+			if r.occurrence(lt, tm).Equal(lt) {
+				hour, minute, second = tm.Clock()
+				break
+			}
+		}
+	}
+
+	for _, part := range []struct {
+		name    string
+		list    []int
+		implied bool
+		value   int
+		unit    string
+	}{
+		{"BYHOUR", r.byhour, len(r.OrigOptions.Byhour) == 0, hour, "hour"},
+		{"BYMINUTE", r.byminute, len(r.OrigOptions.Byminute) == 0, minute, "minute"},
+		{"BYSECOND", r.bysecond, len(r.OrigOptions.Bysecond) == 0, second, "second"},
+	} {
+		if len(part.list) != 0 {
+			ok := contains(part.list, part.value)
+			step(part.name, part.implied, ok, "%s %d %s %s", part.unit, part.value, inOrNot(ok), joinInts(part.list))
+		}
+	}
+}
+
+// allAccepted reports whether every step accepted the time.
+func allAccepted(steps []ExplanationStep) bool {
+	for _, step := range steps {
+		if !step.Accepted {
+			return false
+		}
+	}
+	return true
+}
+
+func inOrNot(ok bool) string {
+	if ok {
+		return "is in"
+	}
+	return "is not in"
+}
+
+func isOrNot(ok bool) string {
+	if ok {
+		return "is"
+	}
+	return "is not"
+}
+
+func matchesOrNot(ok bool) string {
+	if ok {
+		return "matches"
+	}
+	return "does not match"
+}
+
+func skippedOrNot(ok bool) string {
+	if ok {
+		return "not skipped"
+	}
+	return "skipped"
+}
+
+func selectedOrNot(ok bool) string {
+	if ok {
+		return "selected"
+	}
+	return "not selected"
+}
+
+func joinInts(list []int) string {
+	s := make([]string, len(list))
+	for i, v := range list {
+		s[i] = fmt.Sprint(v)
+	}
+	return strings.Join(s, ",")
+}
+
+func joinWeekdays(list []Weekday) string {
+	s := make([]string, len(list))
+	for i, w := range list {
+		s[i] = w.String()
+	}
+	return strings.Join(s, ",")
+}
@@ -0,0 +1,104 @@
+package rrule
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExplain(t *testing.T) {
+	set, err := StrToRRuleSet("DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=2;COUNT=3\nEXDATE:20240331T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	var tests = []struct {
+		t          time.Time
+		occurrence bool
+		rejected   []string
+	}{
+		{time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC), true, nil},
+		{time.Date(2024, 1, 30, 9, 0, 0, 0, time.UTC), false, []string{"DTSTART", "BYMONTHDAY"}},
+		{time.Date(2024, 4, 30, 9, 0, 0, 0, time.UTC), false, []string{"INTERVAL", "BYMONTHDAY"}},
+		{time.Date(2024, 9, 30, 9, 0, 0, 0, time.UTC), false, []string{"BYMONTHDAY"}},
+		{time.Date(2024, 5, 31, 10, 0, 0, 0, time.UTC), false, []string{"BYHOUR"}},
+		{time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC), false, []string{"EXDATE"}},
+		{time.Date(2025, 1, 31, 9, 0, 0, 0, time.UTC), false, []string{"COUNT"}},
+	}
+
+	for _, tt := range tests {
+		e := set.Explain(tt.t)
+		if e.Occurrence != tt.occurrence {
+			t.Errorf("%v: expected occurrence %v, got %v", tt.t, tt.occurrence, e.Occurrence)
+		}
+
+		var rejected []string
+		for _, step := range e.Rejected() {
+			rejected = append(rejected, step.Part)
+		}
+		if strings.Join(rejected, ",") != strings.Join(tt.rejected, ",") {
+			t.Errorf("%v: expected %v rejected, got %v", tt.t, tt.rejected, rejected)
+		}
+	}
+
+	expected := "2024-09-30 09:00:00 +0000 UTC is not an occurrence\n" +
+		"  DTSTART accepted: 2024-09-30 09:00:00 +0000 UTC is not before DTSTART 2024-01-31 09:00:00 +0000 UTC\n" +
+		"  INTERVAL accepted: the monthly period of 2024-09-30 is not skipped by INTERVAL=2\n" +
+		"  BYMONTHDAY rejected: day 30 (-1) of the month is not in 31 (from DTSTART)\n" +
+		"  BYHOUR accepted: hour 9 is in 9 (from DTSTART)\n" +
+		"  BYMINUTE accepted: minute 0 is in 0 (from DTSTART)\n" +
+		"  BYSECOND accepted: second 0 is in 0 (from DTSTART)\n" +
+		"  EXDATE accepted: 2024-09-30 09:00:00 +0000 UTC is not an EXDATE value"
+	if got := set.Explain(time.Date(2024, 9, 30, 9, 0, 0, 0, time.UTC)).String(); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
+
+func TestExplainAgreesWithContains(t *testing.T) {
+	var rules = []string{
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;COUNT=10;BYMONTH=1,3;BYDAY=1TU,-1TH",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;UNTIL=20100101T000000Z;BYWEEKNO=1,20;BYDAY=MO,SU",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=2;COUNT=10;BYYEARDAY=1,100,-1",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;COUNT=10;BYEASTER=-2,1",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=3;COUNT=20;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1,1",
+		"DTSTART:19971230T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=3;WKST=SU;COUNT=20;BYDAY=TU,SU",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=5;COUNT=50;BYDAY=MO,FR;BYMINUTE=0,30",
+		"DTSTART:19970902T090000Z\nRRULE:FREQ=MINUTELY;INTERVAL=7;COUNT=100;BYHOUR=9,10",
+		"DTSTART;TZID=America/New_York:20240301T023000\nRRULE:FREQ=DAILY;COUNT=40;BYHOUR=1,2,3",
+	}
+
+	for _, rule := range rules {
+		r, err := StrToRRule(rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", rule, err)
+		}
+
+		for _, dt := range r.All() {
+			for _, candidate := range []time.Time{dt, dt.Add(time.Minute), dt.Add(time.Hour), dt.AddDate(0, 0, 1), dt.AddDate(0, 0, -7)} {
+				if got, want := r.Explain(candidate).Occurrence, r.Contains(candidate); got != want {
+					t.Errorf("%q: Explain(%v) expected occurrence %v, got %v", rule, candidate, want, got)
+				}
+			}
+		}
+	}
+}
+
+func TestExplainDST(t *testing.T) {
+	// The spring forward of 2025-03-09 moves the last hour of that Sunday past
+	// midnight, so the occurrence is on a Monday.
+	r, _ := StrToRRule("DTSTART;TZID=America/New_York:20250210T092000\nRRULE:FREQ=HOURLY;BYDAY=SU,WE;UNTIL=20270301T000000Z")
+	ny, _ := time.LoadLocation("America/New_York")
+	dt := time.Date(2025, 3, 10, 0, 20, 0, 0, ny)
+
+	if !r.Contains(dt) {
+		t.Fatalf("expected %v to be an occurrence", dt)
+	}
+	if e := r.Explain(dt); !e.Occurrence {
+		t.Errorf("expected %v to be explained as an occurrence, got\n%v", dt, e)
+	}
+
+	dt = time.Date(2025, 3, 10, 1, 20, 0, 0, ny)
+	if e := r.Explain(dt); e.Occurrence || len(e.Rejected()) != 1 || e.Rejected()[0].Part != "BYDAY" {
+		t.Errorf("expected %v to be rejected by BYDAY, got\n%v", dt, e)
+	}
+}
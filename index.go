@@ -0,0 +1,357 @@
+package rrule
+
+import (
+	"sort"
+	"time"
+)
+
+// Nth returns the occurrence at index n of the rule, counted from 0, and true,
+// or false if there is none. A negative n counts from the end of a finite rule,
+// -1 being its last occurrence, and finds nothing in an infinite one.
+//
+// Daily and weekly rules selecting days only with BYDAY are computed directly,
+// other rules are iterated up to the occurrence.
+func (r *RRule) Nth(n int) (time.Time, bool) {
+	if n < 0 {
+		if !r.IsFinite() {
+			return time.Time{}, false
+		}
+		n += r.total()
+	}
+	if n < 0 {
+		return time.Time{}, false
+	}
+
+	return r.nth(n)
+}
+
+// IndexOf returns the index of the occurrence t of the rule, counted from 0 as by
+// Nth, and true. If t is not an occurrence, it returns the number of occurrences
+// before t and false.
+//
+// Daily and weekly rules selecting days only with BYDAY are computed directly,
+// other rules are iterated up to t.
+func (r *RRule) IndexOf(t time.Time) (int, bool) {
+	return r.index(t)
+}
+
+// Nth returns the occurrence at index n of the set, counted from 0, and true, or
+// false if there is none. A negative n counts from the end of a finite set, -1
+// being its last occurrence, and finds nothing in an infinite one.
+//
+// The index accounts for RDATE and EXDATE values, and the RRULE is looked up as
+// by RRule.Nth, so that simple rules are not iterated.
+func (set *Set) Nth(n int) (time.Time, bool) {
+	idx := set.indexer()
+	if n < 0 {
+		if !set.IsFinite() {
+			return time.Time{}, false
+		}
+		n += idx.total()
+	}
+	if n < 0 {
+		return time.Time{}, false
+	}
+
+	return idx.nth(n)
+}
+
+// IndexOf returns the index of the occurrence t of the set, counted from 0 as by
+// Nth, and true. If t is not an occurrence, it returns the number of occurrences
+// before t and false.
+func (set *Set) IndexOf(t time.Time) (int, bool) {
+	return set.indexer().index(t)
+}
+
+// setIndexer looks up the occurrences of a set from those of its RRULE, its
+// RDATE values that are not occurrences of the RRULE, and the indexes of the
+// occurrences of the RRULE that are excluded.
+type setIndexer struct {
+	rrule    *RRule
+	rdate    []time.Time
+	excluded []int
+}
+
+func (set *Set) indexer() setIndexer {
+	idx := setIndexer{rrule: set.rrule}
+	exdate := uniqueTimes(set.exdate)
+	isExcluded := func(t time.Time) bool {
+		i := sort.Search(len(exdate), func(i int) bool { return !exdate[i].Before(t) })
+		return i < len(exdate) && exdate[i].Equal(t)
+	}
+
+	for _, rdate := range uniqueTimes(set.rdate) {
+		if isExcluded(rdate) {
+			continue
+		}
+		if set.rrule != nil {
+			if _, ok := set.rrule.index(rdate); ok {
+				continue
+			}
+		}
+		idx.rdate = append(idx.rdate, rdate)
+	}
+
+	if set.rrule != nil {
+		for _, t := range exdate {
+			if i, ok := set.rrule.index(t); ok {
+				idx.excluded = append(idx.excluded, i)
+			}
+		}
+	}
+
+	return idx
+}
+
+// ruleIndex returns the number of occurrences of the RRULE before t that are
+// not excluded, and whether t is one of them.
+func (idx setIndexer) ruleIndex(t time.Time) (int, bool) {
+	if idx.rrule == nil {
+		return 0, false
+	}
+
+	i, ok := idx.rrule.index(t)
+	excluded := sort.SearchInts(idx.excluded, i)
+	if excluded < len(idx.excluded) && idx.excluded[excluded] == i {
+		ok = false
+	}
+
+	return i - excluded, ok
+}
+
+// ruleNth returns the occurrence of the RRULE at index n among those that are
+// not excluded.
+func (idx setIndexer) ruleNth(n int) (time.Time, bool) {
+	if idx.rrule == nil {
+		return time.Time{}, false
+	}
+
+	for _, i := range idx.excluded {
+		if i <= n {
+			n++
+		}
+	}
+
+	return idx.rrule.nth(n)
+}
+
+func (idx setIndexer) index(t time.Time) (int, bool) {
+	i, ok := idx.ruleIndex(t)
+	rdates := sort.Search(len(idx.rdate), func(j int) bool { return !idx.rdate[j].Before(t) })
+	if rdates < len(idx.rdate) && idx.rdate[rdates].Equal(t) {
+		ok = true
+	}
+
+	return i + rdates, ok
+}
+
+// nth merges the occurrences of the RRULE with the RDATE values: the occurrence
+// at index n is preceded by some b RDATE values and n-b occurrences of the RRULE.
+func (idx setIndexer) nth(n int) (time.Time, bool) {
+	for b := 0; b <= len(idx.rdate) && b <= n; b++ {
+		if b < len(idx.rdate) {
+			if i, _ := idx.ruleIndex(idx.rdate[b]); i == n-b {
+				return idx.rdate[b], true
+			}
+		}
+
+		dt, ok := idx.ruleNth(n - b)
+		if ok && (b == 0 || idx.rdate[b-1].Before(dt)) && (b == len(idx.rdate) || dt.Before(idx.rdate[b])) {
+			return dt, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (idx setIndexer) total() int {
+	total := len(idx.rdate)
+	if idx.rrule != nil {
+		total += idx.rrule.total() - len(idx.excluded)
+	}
+
+	return total
+}
+
+// total returns the number of occurrences of a finite rule.
+func (r *RRule) total() int {
+	if p := r.periodic(); p != nil {
+		return p.total()
+	}
+
+	return r.Count()
+}
+
+func (r *RRule) nth(n int) (time.Time, bool) {
+	if p := r.periodic(); p != nil {
+		if n >= p.total() {
+			return time.Time{}, false
+		}
+		return p.occurrence(n), true
+	}
+
+	next := r.Iterator()
+	for dt, ok := next(); ok; dt, ok = next() {
+		if n == 0 {
+			return dt, true
+		}
+		n--
+	}
+
+	return time.Time{}, false
+}
+
+func (r *RRule) index(t time.Time) (int, bool) {
+	if p := r.periodic(); p != nil {
+		i, ok := p.countBefore(t)
+		if total := p.total(); i >= total {
+			return total, false
+		}
+		return i, ok
+	}
+
+	i := 0
+	next := r.Iterator()
+	for dt, ok := next(); ok && !dt.After(t); dt, ok = next() {
+		if dt.Equal(t) {
+			return i, true
+		}
+		i++
+	}
+
+	return i, false
+}
+
+// periodic describes a rule whose periods all have the same occurrences, at the
+// same days from their start and times of day.
+type periodic struct {
+	rrule *RRule
+	// start is the day number of the first period and step the days between
+	// the start of two periods.
+	start, step int
+	// days are the days from the start of a period and times the times of day
+	// of its occurrences, in order.
+	days  []int
+	times []time.Time
+	// head is the number of occurrences of the first period, which are not
+	// before DTSTART.
+	head int
+}
+
+// periodic returns the description of a daily rule with no BYxxx day part or a
+// weekly rule with no BYxxx day part other than BYDAY, or nil for other rules.
+func (r *RRule) periodic() *periodic {
+	if r.freq != DAILY && r.freq != WEEKLY || r.freq == DAILY && len(r.byweekday) != 0 ||
+		len(r.bysetpos) != 0 || len(r.bymonth) != 0 || len(r.bymonthday) != 0 || len(r.bynmonthday) != 0 ||
+		len(r.byyearday) != 0 || len(r.byweekno) != 0 || len(r.bynweekday) != 0 || len(r.byeaster) != 0 {
+		return nil
+	}
+
+	p := &periodic{rrule: r, start: dayNumber(r.dtstart), step: r.interval}
+	offsets := []int{0}
+	if r.freq == WEEKLY {
+		p.start -= pymod(toPyWeekday(r.dtstart.Weekday())-r.wkst, 7)
+		p.step *= 7
+
+		offsets = nil
+		for offset := 0; offset < 7; offset++ {
+			if contains(r.byweekday, pymod(r.wkst+offset, 7)) {
+				offsets = append(offsets, offset)
+			}
+		}
+	}
+
+	for _, offset := range offsets {
+		for _, tm := range r.timeset {
+			p.days = append(p.days, offset)
+			p.times = append(p.times, tm)
+		}
+	}
+
+	for i := range p.days {
+		if !p.at(0, i).Before(r.dtstart) {
+			p.head++
+		}
+	}
+
+	return p
+}
+
+// at returns the occurrence i of the period, which may be before DTSTART in the
+// first period.
+func (p *periodic) at(period, i int) time.Time {
+	year, month, day := fromDayNumber(p.start + period*p.step + p.days[i])
+	hour, minute, second := p.times[i].Clock()
+
+	return time.Date(year, month, day, hour, minute, second, p.times[i].Nanosecond(), p.rrule.dtstart.Location())
+}
+
+// occurrence returns the occurrence at index n, regardless of COUNT and UNTIL.
+func (p *periodic) occurrence(n int) time.Time {
+	if n < p.head {
+		return p.at(0, len(p.days)-p.head+n)
+	}
+
+	period, i := divmod(n-p.head, len(p.days))
+	return p.at(period+1, i)
+}
+
+// countBefore returns the number of occurrences before t, regardless of COUNT and
+// UNTIL, and whether t is an occurrence.
+func (p *periodic) countBefore(t time.Time) (int, bool) {
+	period, _ := divmod(dayNumber(t.In(p.rrule.dtstart.Location()))-p.start, p.step)
+	if period < 0 {
+		return 0, false
+	}
+
+	count, found := 0, false
+	if period > 0 {
+		count = p.head + (period-1)*len(p.days)
+	}
+
+	// A time of day moved by a daylight saving time transition may put an
+	// occurrence of the next period before t.
+	for q := period; q <= period+1; q++ {
+		for i := range p.days {
+			dt := p.at(q, i)
+			if q == 0 && dt.Before(p.rrule.dtstart) {
+				continue
+			}
+			if dt.Before(t) {
+				count++
+			} else if dt.Equal(t) {
+				found = true
+			}
+		}
+	}
+
+	return count, found
+}
+
+// total returns the number of occurrences up to COUNT and UNTIL, which ends the
+// iteration of infinite rules about 290 years after DTSTART, or MAXYEAR.
+func (p *periodic) total() int {
+	end := time.Date(MAXYEAR+1, 1, 1, 0, 0, 0, 0, p.rrule.dtstart.Location())
+	count, _ := p.countBefore(end)
+	if !p.rrule.until.After(end) {
+		until, found := p.countBefore(p.rrule.until)
+		if count = until; found {
+			count++
+		}
+	}
+	if p.rrule.count > 0 && p.rrule.count < count {
+		count = p.rrule.count
+	}
+
+	return count
+}
+
+// dayNumber returns the number of days from the Unix epoch to the date of t.
+func dayNumber(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+func fromDayNumber(n int) (int, time.Month, int) {
+	return time.Unix(int64(n)*86400, 0).UTC().Date()
+}
@@ -0,0 +1,115 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNthAndIndexOf(t *testing.T) {
+	var rules = []string{
+		"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=20",
+		"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;INTERVAL=3;UNTIL=20240301T090000Z;BYHOUR=9,12,18",
+		"DTSTART:20240103T120000Z\nRRULE:FREQ=WEEKLY;COUNT=30;BYDAY=MO,WE,FR;BYHOUR=9,12",
+		"DTSTART:20240103T120000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;UNTIL=20240601T000000Z;BYDAY=TU,SU",
+		"DTSTART;TZID=America/New_York:20240301T023000\nRRULE:FREQ=DAILY;COUNT=40;BYHOUR=1,2,3",
+		"DTSTART;TZID=America/New_York:20241020T013000\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=SU,MO",
+		"DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;COUNT=20;BYDAY=-1FR",
+		"DTSTART:20240101T090000Z\nRRULE:FREQ=HOURLY;INTERVAL=5;COUNT=50",
+	}
+
+	for _, rule := range rules {
+		r, err := StrToRRule(rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", rule, err)
+		}
+		all := r.All()
+
+		for i, dt := range all {
+			if got, ok := r.Nth(i); !ok || !got.Equal(dt) {
+				t.Errorf("%q: Nth(%d) expected %v, got %v, %v", rule, i, dt, got, ok)
+			}
+			if got, ok := r.Nth(i - len(all)); !ok || !got.Equal(dt) {
+				t.Errorf("%q: Nth(%d) expected %v, got %v, %v", rule, i-len(all), dt, got, ok)
+			}
+			if i > 0 && all[i-1].Equal(dt) {
+				// Times of day moved into the same instant by a daylight saving
+				// time transition are found at their first index.
+				continue
+			}
+			if got, ok := r.IndexOf(dt); !ok || got != i {
+				t.Errorf("%q: IndexOf(%v) expected %d, got %d, %v", rule, dt, i, got, ok)
+			}
+			if got, ok := r.IndexOf(dt.Add(-time.Second)); ok || got != i {
+				t.Errorf("%q: IndexOf(%v) expected %d, false, got %d, %v", rule, dt.Add(-time.Second), i, got, ok)
+			}
+		}
+
+		if _, ok := r.Nth(len(all)); ok {
+			t.Errorf("%q: Nth(%d) expected no occurrence", rule, len(all))
+		}
+		if _, ok := r.Nth(-len(all) - 1); ok {
+			t.Errorf("%q: Nth(%d) expected no occurrence", rule, -len(all)-1)
+		}
+		if got, ok := r.IndexOf(all[len(all)-1].Add(time.Second)); ok || got != len(all) {
+			t.Errorf("%q: IndexOf after the last occurrence expected %d, false, got %d, %v", rule, len(all), got, ok)
+		}
+	}
+}
+
+func TestNthInfinite(t *testing.T) {
+	r, _ := StrToRRule("DTSTART:20240101T000000Z\nRRULE:FREQ=DAILY;BYHOUR=0,6,12,18")
+	if r.periodic() == nil {
+		t.Fatal("expected the rule to be computed directly")
+	}
+
+	expected := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, 25000).Add(12 * time.Hour)
+	if got, ok := r.Nth(100002); !ok || !got.Equal(expected) {
+		t.Errorf("expected %v, got %v, %v", expected, got, ok)
+	}
+	if got, ok := r.IndexOf(expected); !ok || got != 100002 {
+		t.Errorf("expected 100002, got %d, %v", got, ok)
+	}
+	if _, ok := r.Nth(-1); ok {
+		t.Error("expected no last occurrence of an infinite rule")
+	}
+}
+
+func TestSetNthAndIndexOf(t *testing.T) {
+	var sets = []string{
+		"DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=10\n" +
+			"RDATE:20231231T090000Z,20240103T090000Z,20240103T120000Z,20240120T090000Z\n" +
+			"EXDATE:20240101T090000Z,20240105T090000Z,20240103T120000Z,20240107T100000Z",
+		"DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;COUNT=5;BYMONTHDAY=-1\n" +
+			"RDATE:20240115T090000Z\nEXDATE:20240229T090000Z",
+		"DTSTART:20240101T090000Z\nRDATE:20240105T090000Z,20240102T090000Z\nEXDATE:20240105T090000Z",
+	}
+
+	for _, str := range sets {
+		set, err := StrToRRuleSet(str)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", str, err)
+		}
+		all := set.All()
+
+		for i, dt := range all {
+			if got, ok := set.Nth(i); !ok || !got.Equal(dt) {
+				t.Errorf("%q: Nth(%d) expected %v, got %v, %v", str, i, dt, got, ok)
+			}
+			if got, ok := set.Nth(i - len(all)); !ok || !got.Equal(dt) {
+				t.Errorf("%q: Nth(%d) expected %v, got %v, %v", str, i-len(all), dt, got, ok)
+			}
+			if got, ok := set.IndexOf(dt); !ok || got != i {
+				t.Errorf("%q: IndexOf(%v) expected %d, got %d, %v", str, dt, i, got, ok)
+			}
+		}
+
+		if _, ok := set.Nth(len(all)); ok {
+			t.Errorf("%q: Nth(%d) expected no occurrence", str, len(all))
+		}
+		for _, exdate := range set.GetExDate() {
+			if _, ok := set.IndexOf(exdate); ok {
+				t.Errorf("%q: IndexOf(%v) expected an excluded date", str, exdate)
+			}
+		}
+	}
+}
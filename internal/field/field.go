@@ -0,0 +1,194 @@
+// Package field parses and formats the lists of values of the fields of cron
+// and systemd calendar event expressions, such as "1,15", "MON-FRI", "*/15" or
+// "09..11,17".
+package field
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Field is a parsed field: its sorted values, and whether it is every value.
+type Field struct {
+	Values []int
+	All    bool
+}
+
+// Syntax is how an expression writes the values of its fields.
+type Syntax struct {
+	// Name prefixes errors, e.g. "cron".
+	Name string
+	// Range separates the bounds of a range, e.g. "-" or "..".
+	Range string
+	// Step names a step a/n in errors, e.g. "step" or "repetition".
+	Step string
+	// Width is the number of digits values are padded to with zeros.
+	Width int
+	// Repeat formats values repeating step from first up to the maximum of a
+	// field starting at min, or returns "" when the syntax cannot write them.
+	Repeat func(first, step, min int) string
+}
+
+// Bounds are the values of a field.
+type Bounds struct {
+	Min, Max int
+	// Names are the values of names, matched in any case, e.g. "JAN" for 1.
+	Names map[string]int
+	// Reversed repeats a step down to Min rather than up to Max, as for the days
+	// counted from the end of the month.
+	Reversed bool
+}
+
+// All returns the field of every value from min to max.
+func All(min, max int) Field {
+	values := make([]int, 0, max-min+1)
+	for v := min; v <= max; v++ {
+		values = append(values, v)
+	}
+	return Field{Values: values, All: true}
+}
+
+// Parse parses s, "*" or a list of values, ranges and steps, as a field of the
+// values within b. A step a/n without a range repeats from a to b.Max, or down
+// to b.Min when reversed.
+func (syn Syntax) Parse(s string, b Bounds) (Field, error) {
+	if s == "*" {
+		return All(b.Min, b.Max), nil
+	}
+
+	var values []int
+	for _, item := range strings.Split(s, ",") {
+		step := 0
+		rng := item
+		if i := strings.Index(item, "/"); i >= 0 {
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return Field{}, fmt.Errorf("%s: bad %s in %q", syn.Name, syn.Step, item)
+			}
+			step, rng = n, item[:i]
+		}
+
+		from, to := b.Min, b.Max
+		if rng != "*" {
+			bounds := strings.SplitN(rng, syn.Range, 2)
+			var err error
+			if from, err = syn.Value(bounds[0], b); err != nil {
+				return Field{}, err
+			}
+			switch {
+			case len(bounds) == 2:
+				if to, err = syn.Value(bounds[1], b); err != nil {
+					return Field{}, err
+				}
+			case step == 0:
+				to = from
+			case b.Reversed:
+				to = b.Min
+			}
+		}
+
+		if step == 0 {
+			step = 1
+		}
+		if b.Reversed && to < from {
+			for v := from; v >= to; v -= step {
+				values = append(values, v)
+			}
+			continue
+		}
+		if to < from {
+			return Field{}, fmt.Errorf("%s: bad range %q", syn.Name, item)
+		}
+		for v := from; v <= to; v += step {
+			values = append(values, v)
+		}
+	}
+
+	values = Unique(values)
+	return Field{Values: values, All: len(values) == b.Max-b.Min+1}, nil
+}
+
+// Value parses a value within b, or one of its names.
+func (syn Syntax) Value(s string, b Bounds) (int, error) {
+	if v, ok := b.Names[strings.ToUpper(s)]; ok {
+		return v, nil
+	}
+
+	v, err := strconv.Atoi(s)
+	if err != nil || v < b.Min || v > b.Max {
+		return 0, fmt.Errorf("%s: %q is not between %d and %d", syn.Name, s, b.Min, b.Max)
+	}
+	return v, nil
+}
+
+// Format returns the values as a field, "*" when they are every value from min
+// to max, a repetition when they are 3 or more steps reaching max and Repeat
+// writes them, and with runs of 3 or more values as ranges otherwise.
+func (syn Syntax) Format(values []int, min, max int) string {
+	values = Unique(values)
+	if len(values) == 0 || len(values) == max-min+1 {
+		return "*"
+	}
+	if step := Step(values); step > 1 && len(values) >= 3 && values[len(values)-1]+step > max {
+		if s := syn.Repeat(values[0], step, min); s != "" {
+			return s
+		}
+	}
+
+	format := fmt.Sprintf("%%0%dd", syn.Width)
+	return syn.FormatRuns(values, func(v int) string { return fmt.Sprintf(format, v) })
+}
+
+// FormatRuns returns the values, written by name, with runs of 3 or more values
+// as ranges.
+func (syn Syntax) FormatRuns(values []int, name func(int) string) string {
+	values = Unique(values)
+
+	var items []string
+	for i := 0; i < len(values); {
+		j := i
+		for j+1 < len(values) && values[j+1] == values[j]+1 {
+			j++
+		}
+		if j-i >= 2 {
+			items = append(items, name(values[i])+syn.Range+name(values[j]))
+		} else {
+			for k := i; k <= j; k++ {
+				items = append(items, name(values[k]))
+			}
+		}
+		i = j + 1
+	}
+	return strings.Join(items, ",")
+}
+
+// Step returns the difference between the sorted values if it is constant, or
+// 0.
+func Step(values []int) int {
+	if len(values) < 2 {
+		return 0
+	}
+	step := values[1] - values[0]
+	for i := 2; i < len(values); i++ {
+		if values[i]-values[i-1] != step {
+			return 0
+		}
+	}
+	return step
+}
+
+// Unique returns the values sorted, without duplicates.
+func Unique(values []int) []int {
+	seen := make(map[int]bool, len(values))
+	var result []int
+	for _, v := range values {
+		if !seen[v] {
+			seen[v] = true
+			result = append(result, v)
+		}
+	}
+	sort.Ints(result)
+	return result
+}
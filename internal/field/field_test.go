@@ -0,0 +1,110 @@
+package field
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+)
+
+var dashes = Syntax{Name: "test", Range: "-", Step: "step", Repeat: func(first, step, min int) string {
+	if first != min {
+		return ""
+	}
+	return "*/" + strconv.Itoa(step)
+}}
+
+var dots = Syntax{Name: "test", Range: "..", Step: "repetition", Width: 2, Repeat: func(first, step, min int) string {
+	return strconv.Itoa(first) + "/" + strconv.Itoa(step)
+}}
+
+func TestParse(t *testing.T) {
+	months := Bounds{Min: 1, Max: 12, Names: map[string]int{"JAN": 1, "JUL": 7}}
+	tests := []struct {
+		syntax   Syntax
+		s        string
+		bounds   Bounds
+		expected Field
+	}{
+		{dashes, "*", Bounds{Min: 0, Max: 3}, Field{Values: []int{0, 1, 2, 3}, All: true}},
+		{dashes, "0-3", Bounds{Min: 0, Max: 3}, Field{Values: []int{0, 1, 2, 3}, All: true}},
+		{dashes, "5,1,5", Bounds{Min: 0, Max: 59}, Field{Values: []int{1, 5}}},
+		{dashes, "*/15", Bounds{Min: 0, Max: 59}, Field{Values: []int{0, 15, 30, 45}}},
+		{dashes, "10-20/5,3", Bounds{Min: 0, Max: 59}, Field{Values: []int{3, 10, 15, 20}}},
+		{dashes, "50/5", Bounds{Min: 0, Max: 59}, Field{Values: []int{50, 55}}},
+		{dashes, "jan/6", months, Field{Values: []int{1, 7}}},
+		{dashes, "JAN-3,JUL", months, Field{Values: []int{1, 2, 3, 7}}},
+		{dots, "09..11,17", Bounds{Min: 0, Max: 23}, Field{Values: []int{9, 10, 11, 17}}},
+		{dots, "07/2", Bounds{Min: 1, Max: 31, Reversed: true}, Field{Values: []int{1, 3, 5, 7}}},
+		{dots, "03..01", Bounds{Min: 1, Max: 31, Reversed: true}, Field{Values: []int{1, 2, 3}}},
+	}
+
+	for _, test := range tests {
+		got, err := test.syntax.Parse(test.s, test.bounds)
+		if err != nil {
+			t.Errorf("%q: %v", test.s, err)
+		} else if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%q: expected %+v, got %+v", test.s, test.expected, got)
+		}
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, s := range []string{"", "60", "-1", "5-1", "*/0", "1/x", "1-", "JAN", "1..2"} {
+		if _, err := dashes.Parse(s, Bounds{Min: 0, Max: 59}); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+
+	_, err := dots.Parse("0/0", Bounds{Min: 0, Max: 59})
+	if err == nil || err.Error() != `test: bad repetition in "0/0"` {
+		t.Errorf("unexpected error %v", err)
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		syntax   Syntax
+		values   []int
+		min, max int
+		expected string
+	}{
+		{dashes, nil, 0, 59, "*"},
+		{dashes, []int{0, 1, 2, 3}, 0, 3, "*"},
+		{dashes, []int{45, 0, 15, 30, 15}, 0, 59, "*/15"},
+		{dashes, []int{5, 20, 35, 50}, 0, 59, "5,20,35,50"},
+		{dashes, []int{0, 20}, 0, 59, "0,20"},
+		{dashes, []int{9, 10, 11, 17, 18}, 0, 23, "9-11,17,18"},
+		{dots, []int{5, 20, 35, 50}, 0, 59, "5/15"},
+		{dots, []int{1, 2, 3, 7}, 1, 12, "01..03,07"},
+	}
+
+	for _, test := range tests {
+		if got := test.syntax.Format(test.values, test.min, test.max); got != test.expected {
+			t.Errorf("%v: expected %q, got %q", test.values, test.expected, got)
+		}
+	}
+
+	names := []string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+	got := dots.FormatRuns([]int{4, 0, 1, 2, 6}, func(d int) string { return names[d] })
+	if got != "Mon..Wed,Fri,Sun" {
+		t.Errorf("unexpected runs %q", got)
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		values   []int
+		expected int
+	}{
+		{nil, 0},
+		{[]int{3}, 0},
+		{[]int{1, 4, 7}, 3},
+		{[]int{1, 4, 8}, 0},
+	}
+
+	for _, test := range tests {
+		if got := Step(test.values); got != test.expected {
+			t.Errorf("%v: expected %d, got %d", test.values, test.expected, got)
+		}
+	}
+}
@@ -0,0 +1,428 @@
+// Package iso8601 converts ISO 8601 repeating intervals, such as
+// "R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M", to recurrence rules and back.
+//
+// A repeating interval is "R", the number of repetitions, which is omitted or -1
+// when unbounded, the start and either a period or the end of the first interval,
+// separated by "/". Times are written in the extended or basic format, with a
+// "Z" or an offset from UTC, or are in UTC otherwise. A time with an offset of
+// whole hours is in the Etc/GMT zone of that offset, so that the rule can be
+// written in RFC 5545, and a time with another offset in UTC. The occurrences are
+// the start of each interval: the start plus a whole number of periods.
+package iso8601
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xyedo/rrule"
+)
+
+// ErrMixedPeriod is returned by ParseRRule for a period adding both years or
+// months and a fixed duration, such as P1MT12H, which no rule expresses. ParseSet
+// lists the occurrences of bounded intervals with such a period instead.
+var ErrMixedPeriod = errors.New("iso8601: period mixes years or months with a fixed duration")
+
+// timeLayouts are the layouts times are parsed with, in the extended and basic
+// formats.
+var timeLayouts = []string{
+	"2006-01-02T15:04:05Z07:00",
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+	"20060102T150405Z0700",
+	"20060102T1504Z0700",
+	"20060102T150405",
+	"20060102T1504",
+	"20060102",
+}
+
+// period is a duration of calendar years and months, and of a fixed number of
+// seconds. Weeks, days, hours and minutes are fixed since times have a fixed
+// offset from UTC.
+type period struct {
+	months  int
+	seconds int64
+}
+
+// interval is a parsed repeating interval.
+type interval struct {
+	count  int
+	start  time.Time
+	period period
+}
+
+// ParseRRule parses the repeating interval s into a rule generating the start
+// of each of its intervals.
+//
+// A period of years or months from the 29th, 30th or 31st of a month falls on
+// the last day of shorter months, as with time arithmetic in ISO 8601, which the
+// rule expresses with BYMONTHDAY and BYSETPOS. It returns an error wrapping
+// ErrMixedPeriod for periods like P1Y2M10DT2H30M.
+func ParseRRule(s string) (*rrule.RRule, error) {
+	i, err := parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	option, err := i.option()
+	if err != nil {
+		return nil, fmt.Errorf("%w in %q", err, s)
+	}
+
+	return rrule.NewRRule(option)
+}
+
+// ParseSet parses the repeating interval s into a set, with the rule of
+// ParseRRule, or with the start of each interval as an RDATE when the period
+// mixes years or months with a fixed duration. Each interval of such a period
+// then starts at the end of the previous one, and the interval must have a number
+// of repetitions.
+func ParseSet(s string) (*rrule.Set, error) {
+	i, err := parse(s)
+	if err != nil {
+		return nil, err
+	}
+
+	set := &rrule.Set{}
+	option, err := i.option()
+	if err == nil {
+		r, err := rrule.NewRRule(option)
+		if err != nil {
+			return nil, err
+		}
+		set.RRule(r)
+		return set, nil
+	}
+	if i.count == 0 {
+		return nil, fmt.Errorf("%w in %q, without a number of repetitions to list", err, s)
+	}
+
+	set.DTStart(i.start)
+	for k, t := 0, i.start; k < i.count; k, t = k+1, i.period.after(t) {
+		set.RDate(t)
+	}
+	return set, nil
+}
+
+func parse(s string) (interval, error) {
+	var i interval
+
+	parts := strings.Split(strings.TrimSpace(s), "/")
+	if len(parts) != 3 || !strings.HasPrefix(parts[0], "R") {
+		return i, fmt.Errorf("iso8601: %q is not a repeating interval R[n]/start/period", s)
+	}
+
+	if n := parts[0][1:]; n != "" && n != "-1" {
+		count, err := strconv.Atoi(n)
+		if err != nil || count < 1 {
+			return i, fmt.Errorf("iso8601: bad number of repetitions %q in %q", n, s)
+		}
+		i.count = count
+	}
+
+	if strings.HasPrefix(parts[1], "P") {
+		return i, fmt.Errorf("iso8601: %q starts with a period, only start/period and start/end are supported", s)
+	}
+	start, err := parseTime(parts[1])
+	if err != nil {
+		return i, err
+	}
+	i.start = start
+
+	if strings.HasPrefix(parts[2], "P") {
+		if i.period, err = parsePeriod(parts[2]); err != nil {
+			return i, err
+		}
+		return i, nil
+	}
+
+	end, err := parseTime(parts[2])
+	if err != nil {
+		return i, err
+	}
+	if !end.After(start) {
+		return i, fmt.Errorf("iso8601: end %s is not after start %s in %q", parts[2], parts[1], s)
+	}
+	d := end.Sub(start)
+	if d%time.Second != 0 {
+		return i, fmt.Errorf("iso8601: interval of %q is not a whole number of seconds", s)
+	}
+	i.period.seconds = int64(d / time.Second)
+	return i, nil
+}
+
+func parseTime(s string) (time.Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return inNamedZone(t), nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("iso8601: bad time %q", s)
+}
+
+// inNamedZone returns t, parsed with an offset from UTC, in a time zone with a
+// name, which a rule writes as its TZID: the zone of the tz database with that
+// fixed offset, such as Etc/GMT-1 for +01:00, whose sign is inverted. Times with
+// an offset of no such zone, which are not whole hours, are converted to UTC.
+func inNamedZone(t time.Time) time.Time {
+	_, offset := t.Zone()
+	if offset == 0 || offset%3600 != 0 {
+		return t.UTC()
+	}
+
+	loc, err := time.LoadLocation(fmt.Sprintf("Etc/GMT%+d", -offset/3600))
+	if err != nil {
+		return t.UTC()
+	}
+	return t.In(loc)
+}
+
+// parsePeriod parses a period PnYnMnWnDTnHnMnS of whole numbers.
+func parsePeriod(s string) (period, error) {
+	var p period
+	bad := fmt.Errorf("iso8601: bad period %q", s)
+
+	value := s[1:]
+	if value == "" || strings.HasSuffix(value, "T") {
+		return p, bad
+	}
+
+	// The designators left, in order, with their number of seconds, or 0 for
+	// years and months.
+	designators := "YMWD"
+	seconds := []int64{0, 0, 7 * 86400, 86400}
+	inTime := false
+	for value != "" {
+		if value[0] == 'T' {
+			if inTime {
+				return p, bad
+			}
+			designators, seconds, value = "HMS", []int64{3600, 60, 1}, value[1:]
+			inTime = true
+			continue
+		}
+
+		end := strings.IndexFunc(value, func(r rune) bool { return r < '0' || r > '9' })
+		if end <= 0 {
+			return p, bad
+		}
+		n, err := strconv.ParseInt(value[:end], 10, 32)
+		if err != nil {
+			return p, bad
+		}
+		at := strings.IndexByte(designators, value[end])
+		if at < 0 {
+			return p, bad
+		}
+
+		switch {
+		case seconds[at] != 0:
+			p.seconds += n * seconds[at]
+		case designators[at] == 'Y':
+			p.months += int(n) * 12
+		default:
+			p.months += int(n)
+		}
+		designators, seconds, value = designators[at+1:], seconds[at+1:], value[end+1:]
+	}
+
+	if p.months == 0 && p.seconds == 0 {
+		return p, fmt.Errorf("iso8601: period %q is empty", s)
+	}
+	return p, nil
+}
+
+// after returns t plus the period. Years and months are added first, on the last
+// day of the month when the day of t is after it.
+func (p period) after(t time.Time) time.Time {
+	y, m, d := t.Date()
+	months := int(m) - 1 + p.months
+	y, m = y+months/12, time.Month(months%12+1)
+	if last := daysIn(y, m); d > last {
+		d = last
+	}
+
+	t = time.Date(y, m, d, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+	return t.Add(time.Duration(p.seconds) * time.Second)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// option returns the options of the rule generating the interval starts, with
+// the coarsest frequency dividing the period.
+func (i interval) option() (rrule.ROption, error) {
+	option := rrule.ROption{Dtstart: i.start, Count: i.count}
+
+	p := i.period
+	switch {
+	case p.months != 0 && p.seconds != 0:
+		return option, ErrMixedPeriod
+	case p.months%12 == 0 && p.months != 0:
+		option.Freq, option.Interval = rrule.YEARLY, p.months/12
+	case p.months != 0:
+		option.Freq, option.Interval = rrule.MONTHLY, p.months
+	case p.seconds%(7*86400) == 0:
+		option.Freq, option.Interval = rrule.WEEKLY, int(p.seconds/(7*86400))
+	case p.seconds%86400 == 0:
+		option.Freq, option.Interval = rrule.DAILY, int(p.seconds/86400)
+	case p.seconds%3600 == 0:
+		option.Freq, option.Interval = rrule.HOURLY, int(p.seconds/3600)
+	case p.seconds%60 == 0:
+		option.Freq, option.Interval = rrule.MINUTELY, int(p.seconds/60)
+	default:
+		option.Freq, option.Interval = rrule.SECONDLY, int(p.seconds)
+	}
+
+	if option.Interval == 1 {
+		option.Interval = 0
+	}
+
+	// Rules skip the months without the day of DTSTART, where intervals fall on
+	// their last day.
+	if p.months != 0 && shortMonth(i.start, p.months) {
+		if option.Freq == rrule.YEARLY {
+			option.Bymonth = []int{int(i.start.Month())}
+		}
+		for d := 28; d <= i.start.Day(); d++ {
+			option.Bymonthday = append(option.Bymonthday, d)
+		}
+		option.Bysetpos = []int{-1}
+	}
+
+	return option, nil
+}
+
+// FormatRRule formats the rule as a repeating interval starting at its DTSTART,
+// with a period of its INTERVAL in units of its frequency, and the number of its
+// occurrences as the number of repetitions if it has a COUNT or an UNTIL.
+//
+// The start is written with its offset from UTC, so a rule in a time zone with
+// daylight saving time keeps its wall clock time where the interval keeps its
+// offset. Only rules generating the starts of the intervals, as returned by
+// ParseRRule, can be formatted: an error lists the other parts of the rule.
+func FormatRRule(r *rrule.RRule) (string, error) {
+	option := r.OrigOptions
+
+	var parts []string
+	if !lastDayOf(option, r.Options.Dtstart) {
+		for _, part := range []struct {
+			name string
+			set  bool
+		}{
+			{"BYSETPOS", len(option.Bysetpos) != 0},
+			{"BYMONTH", len(option.Bymonth) != 0},
+			{"BYMONTHDAY", len(option.Bymonthday) != 0},
+		} {
+			if part.set {
+				parts = append(parts, part.name)
+			}
+		}
+	}
+	for _, part := range []struct {
+		name string
+		set  bool
+	}{
+		{"BYYEARDAY", len(option.Byyearday) != 0},
+		{"BYWEEKNO", len(option.Byweekno) != 0},
+		{"BYDAY", len(option.Byweekday) != 0},
+		{"BYHOUR", len(option.Byhour) != 0},
+		{"BYMINUTE", len(option.Byminute) != 0},
+		{"BYSECOND", len(option.Bysecond) != 0},
+		{"BYEASTER", len(option.Byeaster) != 0},
+	} {
+		if part.set {
+			parts = append(parts, part.name)
+		}
+	}
+	if len(parts) != 0 {
+		return "", fmt.Errorf("iso8601: cannot express %s", strings.Join(parts, ", "))
+	}
+
+	count := ""
+	if option.Count != 0 || !option.Until.IsZero() {
+		bounded, err := r.WithCountFromUntil()
+		if err != nil {
+			return "", fmt.Errorf("iso8601: %v", err)
+		}
+		count = strconv.Itoa(bounded.OrigOptions.Count)
+	}
+
+	interval := r.Options.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	n := strconv.Itoa(interval)
+	var p string
+	switch option.Freq {
+	case rrule.YEARLY:
+		p = "P" + n + "Y"
+	case rrule.MONTHLY:
+		p = "P" + n + "M"
+	case rrule.WEEKLY:
+		p = "P" + n + "W"
+	case rrule.DAILY:
+		p = "P" + n + "D"
+	case rrule.HOURLY:
+		p = "PT" + n + "H"
+	case rrule.MINUTELY:
+		p = "PT" + n + "M"
+	default:
+		p = "PT" + n + "S"
+	}
+
+	return "R" + count + "/" + r.Options.Dtstart.Format(timeLayouts[0]) + "/" + p, nil
+}
+
+// shortMonth tells whether a month reached from start by steps of months is
+// shorter than the day of start, counting February as 28 days.
+func shortMonth(start time.Time, months int) bool {
+	for k := 0; k < 12; k++ {
+		m := (int(start.Month())-1+k*months)%12 + 1
+		if daysIn(2023, time.Month(m)) < start.Day() {
+			return true
+		}
+	}
+	return false
+}
+
+// lastDayOf tells whether the options have no BYSETPOS, BYMONTH and BYMONTHDAY
+// but the ones ParseRRule adds to keep to the last day of shorter months.
+func lastDayOf(option rrule.ROption, dtstart time.Time) bool {
+	if len(option.Bysetpos) == 0 && len(option.Bymonth) == 0 && len(option.Bymonthday) == 0 {
+		return true
+	}
+	if option.Freq != rrule.YEARLY && option.Freq != rrule.MONTHLY {
+		return false
+	}
+
+	i := interval{start: dtstart}
+	i.period.months = option.Interval
+	if i.period.months < 1 {
+		i.period.months = 1
+	}
+	if option.Freq == rrule.YEARLY {
+		i.period.months *= 12
+	}
+	expected, _ := i.option()
+	return equalInts(option.Bysetpos, expected.Bysetpos) &&
+		equalInts(option.Bymonth, expected.Bymonth) &&
+		equalInts(option.Bymonthday, expected.Bymonthday)
+}
+
+func equalInts(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
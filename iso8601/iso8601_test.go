@@ -0,0 +1,235 @@
+package iso8601
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xyedo/rrule"
+)
+
+func TestParseRRule(t *testing.T) {
+	tests := []struct {
+		s        string
+		expected string
+	}{
+		{"R5/2008-03-01T13:00:00Z/P1Y", "DTSTART:20080301T130000Z\nRRULE:FREQ=YEARLY;COUNT=5"},
+		{"R/2008-03-01T13:00:00Z/P1Y2M", "DTSTART:20080301T130000Z\nRRULE:FREQ=MONTHLY;INTERVAL=14"},
+		{"R-1/20080301T1300Z/P2W", "DTSTART:20080301T130000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2"},
+		{"R3/2008-03-01/P1W2D", "DTSTART:20080301T000000Z\nRRULE:FREQ=DAILY;INTERVAL=9;COUNT=3"},
+		{"R3/2008-03-01T13:00:00Z/P1DT2H", "DTSTART:20080301T130000Z\nRRULE:FREQ=HOURLY;INTERVAL=26;COUNT=3"},
+		{"R/2008-03-01T13:00:00Z/PT1H30M", "DTSTART:20080301T130000Z\nRRULE:FREQ=MINUTELY;INTERVAL=90"},
+		{"R2/2008-03-01T13:00:00Z/PT45S", "DTSTART:20080301T130000Z\nRRULE:FREQ=SECONDLY;INTERVAL=45;COUNT=2"},
+		{"R2/2008-03-01T13:00:00Z/2008-03-01T15:30:00Z", "DTSTART:20080301T130000Z\nRRULE:FREQ=MINUTELY;INTERVAL=150;COUNT=2"},
+		{"R/2024-01-31T09:00:00Z/P1M", "DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;BYSETPOS=-1;BYMONTHDAY=28,29,30,31"},
+		{"R/2024-01-31T09:00:00Z/P6M", "DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;INTERVAL=6"},
+		{"R/2024-02-29T09:00:00Z/P1Y", "DTSTART:20240229T090000Z\nRRULE:FREQ=YEARLY;BYSETPOS=-1;BYMONTH=2;BYMONTHDAY=28,29"},
+	}
+
+	for _, test := range tests {
+		r, err := ParseRRule(test.s)
+		if err != nil {
+			t.Errorf("%q: %v", test.s, err)
+		} else if r.String() != test.expected {
+			t.Errorf("%q: expected %q, got %q", test.s, test.expected, r.String())
+		}
+	}
+}
+
+func TestParseRRuleOffset(t *testing.T) {
+	r, err := ParseRRule("R2/2008-03-01T13:00:00+01:00/P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []time.Time{
+		time.Date(2008, 3, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2008, 3, 2, 12, 0, 0, 0, time.UTC),
+	}
+	all := r.All()
+	if len(all) != len(expected) || !all[0].Equal(expected[0]) || !all[1].Equal(expected[1]) {
+		t.Errorf("expected %v, got %v", expected, all)
+	}
+	if _, offset := all[0].Zone(); offset != 3600 {
+		t.Errorf("expected an offset of 1h, got %ds", offset)
+	}
+
+	for _, s := range []string{
+		"R2/2008-03-01T13:00:00+01:00/P1D",
+		"R/2024-08-31T09:00:00-05:00/P6M",
+		"R/2024-08-31T09:00:00+05:30/PT12H",
+		"R/2024-08-31T09:00:00+00:00/PT12H",
+	} {
+		r, err := ParseRRule(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := rrule.StrToRRule(r.String())
+		if err != nil {
+			t.Errorf("%q: cannot parse %q back: %v", s, r.String(), err)
+			continue
+		}
+		if !parsed.GetDTStart().Equal(r.GetDTStart()) {
+			t.Errorf("%q: expected DTSTART %v, got %v", s, r.GetDTStart(), parsed.GetDTStart())
+		}
+	}
+}
+
+func TestParseRRuleLastDay(t *testing.T) {
+	r, _ := ParseRRule("R5/2024-01-31T09:00:00Z/P1M")
+	expected := []time.Time{
+		time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 31, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 30, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 5, 31, 9, 0, 0, 0, time.UTC),
+	}
+	if all := r.All(); !reflect.DeepEqual(all, expected) {
+		t.Errorf("expected %v, got %v", expected, all)
+	}
+}
+
+func TestParseSet(t *testing.T) {
+	set, err := ParseSet("R3/2008-01-31T13:00:00Z/P1MT12H")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.GetRRule() != nil {
+		t.Error("expected no rule")
+	}
+
+	expected := []time.Time{
+		time.Date(2008, 1, 31, 13, 0, 0, 0, time.UTC),
+		time.Date(2008, 3, 1, 1, 0, 0, 0, time.UTC),
+		time.Date(2008, 4, 1, 13, 0, 0, 0, time.UTC),
+	}
+	if all := set.All(); !reflect.DeepEqual(all, expected) {
+		t.Errorf("expected %v, got %v", expected, all)
+	}
+
+	// Each interval starts at the end of the previous one: 2024-02-25 plus ten
+	// days, then 2024-04-06 plus ten days.
+	set, err = ParseSet("R3/2024-01-25/P1M10D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected = []time.Time{
+		time.Date(2024, 1, 25, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 16, 0, 0, 0, 0, time.UTC),
+	}
+	if all := set.All(); !reflect.DeepEqual(all, expected) {
+		t.Errorf("expected %v, got %v", expected, all)
+	}
+
+	set, err = ParseSet("R2/2008-03-01T13:00:00Z/P1D")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if set.GetRRule() == nil || len(set.All()) != 2 {
+		t.Errorf("expected a rule with 2 occurrences, got %v", set)
+	}
+}
+
+func TestParseError(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"2008-03-01T13:00:00Z/P1D",
+		"R/2008-03-01T13:00:00Z",
+		"R0/2008-03-01T13:00:00Z/P1D",
+		"Rx/2008-03-01T13:00:00Z/P1D",
+		"R/P1D/2008-03-01T13:00:00Z",
+		"R/2008-13-01T13:00:00Z/P1D",
+		"R/2008-03-01T13:00:00Z/P",
+		"R/2008-03-01T13:00:00Z/PT",
+		"R/2008-03-01T13:00:00Z/P1DT",
+		"R/2008-03-01T13:00:00Z/P0D",
+		"R/2008-03-01T13:00:00Z/P1D1Y",
+		"R/2008-03-01T13:00:00Z/P1.5D",
+		"R/2008-03-01T13:00:00Z/PT1HT1M",
+		"R/2008-03-01T13:00:00Z/2008-03-01T13:00:00Z",
+	} {
+		if _, err := ParseSet(s); err == nil {
+			t.Errorf("%q: expected an error", s)
+		}
+	}
+
+	if _, err := ParseRRule("R5/2008-03-01T13:00:00Z/P1Y2M10DT2H30M"); !errors.Is(err, ErrMixedPeriod) {
+		t.Errorf("expected ErrMixedPeriod, got %v", err)
+	}
+	if _, err := ParseSet("R/2008-03-01T13:00:00Z/P1Y2M10DT2H30M"); !errors.Is(err, ErrMixedPeriod) {
+		t.Errorf("expected ErrMixedPeriod, got %v", err)
+	}
+}
+
+func TestFormatRRule(t *testing.T) {
+	tests := []struct {
+		option   rrule.ROption
+		expected string
+	}{
+		{rrule.ROption{Freq: rrule.YEARLY, Count: 5, Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)},
+			"R5/2008-03-01T13:00:00Z/P1Y"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Interval: 3, Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)},
+			"R/2008-03-01T13:00:00Z/P3M"},
+		{rrule.ROption{Freq: rrule.WEEKLY, Until: time.Date(2008, 3, 20, 0, 0, 0, 0, time.UTC),
+			Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.FixedZone("", 3600))},
+			"R3/2008-03-01T13:00:00+01:00/P1W"},
+		{rrule.ROption{Freq: rrule.DAILY, Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)}, "R/2008-03-01T13:00:00Z/P1D"},
+		{rrule.ROption{Freq: rrule.HOURLY, Interval: 2, Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)},
+			"R/2008-03-01T13:00:00Z/PT2H"},
+		{rrule.ROption{Freq: rrule.MINUTELY, Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)}, "R/2008-03-01T13:00:00Z/PT1M"},
+		{rrule.ROption{Freq: rrule.SECONDLY, Count: 2, Interval: 30, Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)},
+			"R2/2008-03-01T13:00:00Z/PT30S"},
+	}
+
+	for _, test := range tests {
+		r, err := rrule.NewRRule(test.option)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s, err := FormatRRule(r)
+		if err != nil {
+			t.Errorf("%v: %v", r, err)
+		} else if s != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, s)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, s := range []string{
+		"R5/2008-03-01T13:00:00Z/P1Y",
+		"R/2024-01-31T09:00:00Z/P1M",
+		"R/2024-02-29T09:00:00Z/P1Y",
+		"R/2024-08-31T09:00:00-05:00/P6M",
+		"R10/2008-03-01T13:00:00Z/PT90M",
+	} {
+		r, err := ParseRRule(s)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+			continue
+		}
+		got, err := FormatRRule(r)
+		if err != nil {
+			t.Errorf("%q: %v", s, err)
+		} else if got != s {
+			t.Errorf("expected %q, got %q", s, got)
+		}
+	}
+}
+
+func TestFormatRRuleError(t *testing.T) {
+	r, _ := rrule.NewRRule(rrule.ROption{Freq: rrule.MONTHLY, Byweekday: []rrule.Weekday{rrule.MO}, Bymonthday: []int{1},
+		Dtstart: time.Date(2008, 3, 1, 13, 0, 0, 0, time.UTC)})
+	_, err := FormatRRule(r)
+	if err == nil || err.Error() != "iso8601: cannot express BYMONTHDAY, BYDAY" {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	r, _ = rrule.NewRRule(rrule.ROption{Freq: rrule.MONTHLY, Bymonthday: []int{28, 29, 30, 31}, Bysetpos: []int{-1},
+		Dtstart: time.Date(2008, 3, 30, 13, 0, 0, 0, time.UTC)})
+	if _, err := FormatRRule(r); err == nil {
+		t.Error("expected an error for the last day of the month from the 30th")
+	}
+}
@@ -0,0 +1,82 @@
+package rrule
+
+import (
+	"embed"
+	"sync"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+//go:embed active.*.toml
+var localeFiles embed.FS
+
+// localeTags lists the languages with built-in translations, the default first.
+var localeTags = []language.Tag{
+	language.English,
+	language.Indonesian,
+	language.German,
+	language.French,
+	language.Spanish,
+	language.Portuguese,
+	language.Dutch,
+	language.Japanese,
+	language.Chinese,
+}
+
+var localeMatcher = language.NewMatcher(localeTags)
+
+var (
+	localeBundleOnce sync.Once
+	localeBundle     *i18n.Bundle
+)
+
+// SupportedLanguages returns the languages with built-in translations for ToTextIn.
+func SupportedLanguages() []language.Tag {
+	tags := make([]language.Tag, len(localeTags))
+	copy(tags, localeTags)
+
+	return tags
+}
+
+// NewLocaleBundle returns a new i18n bundle holding the built-in translations of
+// SupportedLanguages, with English as its default language.
+// Callers may add messages to it to override or extend the translations.
+func NewLocaleBundle() *i18n.Bundle {
+	bundle := i18n.NewBundle(language.English)
+	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
+
+	entries, err := localeFiles.ReadDir(".")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, entry := range entries {
+		if _, err := bundle.LoadMessageFileFS(localeFiles, entry.Name()); err != nil {
+			panic(err)
+		}
+	}
+
+	return bundle
+}
+
+// sharedLocaleBundle returns the bundle used by ToTextIn.
+func sharedLocaleBundle() *i18n.Bundle {
+	localeBundleOnce.Do(func() {
+		localeBundle = NewLocaleBundle()
+	})
+
+	return localeBundle
+}
+
+// LocaleFormatter returns the TimeFormatter matching the built-in translations
+// of the supported language closest to lang, or the English one if none matches.
+func LocaleFormatter(lang language.Tag) TimeFormatter {
+	_, i, confidence := localeMatcher.Match(lang)
+	if confidence == language.No || i == 0 {
+		return defaultFormatter{}
+	}
+
+	return NewTimeFormatter(localeTags[i])
+}
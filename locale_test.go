@@ -0,0 +1,284 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+
+	"golang.org/x/text/language"
+)
+
+func TestLocaleBundleComplete(t *testing.T) {
+	bundle := NewLocaleBundle()
+	for _, lang := range SupportedLanguages() {
+		if missing := MissingMessages(bundle, lang); len(missing) != 0 {
+			t.Errorf("%s: missing messages %v", lang, missing)
+		}
+	}
+}
+
+func TestLocaleTemplates(t *testing.T) {
+	rules := []string{
+		"FREQ=MINUTELY;INTERVAL=2",
+		"FREQ=HOURLY;UNTIL=20070101T080000Z",
+		"FREQ=DAILY;BYMONTH=1,2;BYHOUR=9,17",
+		"FREQ=DAILY;INTERVAL=2;BYDAY=MO,TU,WE,TH,FR",
+		"FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,TU,WE,TH,FR",
+		"FREQ=WEEKLY;INTERVAL=3;BYDAY=MO,TU,WE,TH,FR,SA,SU",
+		"FREQ=WEEKLY;BYMONTH=3;BYDAY=MO,WE",
+		"FREQ=MONTHLY;INTERVAL=2;BYMONTH=1,7;BYDAY=MO,+1FR",
+		"FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR",
+		"FREQ=MONTHLY;BYDAY=TU;BYMONTHDAY=2,3,4",
+		"FREQ=YEARLY;INTERVAL=4;BYMONTH=11;BYMONTHDAY=-1",
+		"FREQ=YEARLY;BYYEARDAY=100,200;COUNT=3",
+		"FREQ=YEARLY;BYWEEKNO=1,20;BYDAY=MO",
+	}
+
+	for _, lang := range SupportedLanguages() {
+		for _, rule := range rules {
+			r, err := StrToRRule(rule)
+			if err != nil {
+				t.Fatalf("failed to parse rrule: %v", err)
+			}
+
+			if got, err := r.ToTextIn(lang); err != nil || got == "" {
+				t.Errorf("%s %s: got %q, error %v", lang, rule, got, err)
+			}
+
+			got, err := r.toText(sharedLocaleBundle(), TextOptions{
+				Formatter: LocaleFormatter(lang),
+				Languages: []string{lang.String()},
+				Now:       r.GetDTStart(),
+			})
+			if err != nil || got == "" {
+				t.Errorf("%s %s relative: got %q, error %v", lang, rule, got, err)
+			}
+		}
+	}
+}
+
+func TestToTextIn(t *testing.T) {
+	var tests = []struct {
+		lang     language.Tag
+		rule     string
+		expected string
+	}{
+		{lang: language.English, rule: "FREQ=DAILY;COUNT=5", expected: "every day for 5 times"},
+		{lang: language.Korean, rule: "FREQ=DAILY;COUNT=5", expected: "every day for 5 times"},
+		{lang: language.Indonesian, rule: "FREQ=DAILY;COUNT=5", expected: "setiap hari sebanyak 5 kali"},
+		{lang: language.German, rule: "FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30", expected: "jeden Tag um 09:30 und 17:30"},
+		{lang: language.German, rule: "FREQ=MONTHLY;BYDAY=-1FR", expected: "jeden Monat am letzten Freitag"},
+		{lang: language.MustParse("de-AT"), rule: "FREQ=MONTHLY;BYMONTHDAY=3", expected: "jeden Monat am 3."},
+		{lang: language.French, rule: "FREQ=WEEKLY;UNTIL=20070101T080000Z", expected: "chaque semaine, jusqu'au 1er janvier 2007"},
+		{lang: language.Spanish, rule: "FREQ=WEEKLY;INTERVAL=2;BYDAY=MO,WE", expected: "cada 2 semanas el lunes y miércoles"},
+		{lang: language.Portuguese, rule: "FREQ=DAILY;COUNT=1", expected: "todos os dias apenas uma vez"},
+		{lang: language.Portuguese, rule: "FREQ=WEEKLY;BYDAY=MO,WE", expected: "todas as semanas às segundas-feiras e às quartas-feiras"},
+		{lang: language.Dutch, rule: "FREQ=YEARLY;BYDAY=+1FR", expected: "elk jaar op de 1e vrijdag"},
+		{lang: language.Japanese, rule: "FREQ=MONTHLY;BYDAY=+2TU", expected: "毎月第2火曜日"},
+		{lang: language.Chinese, rule: "FREQ=MONTHLY;BYDAY=-2TH", expected: "每月的倒数第2个星期四"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.lang.String()+" "+tt.rule, func(t *testing.T) {
+			r, err := StrToRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rrule: %v", err)
+			}
+
+			got, err := r.ToTextIn(tt.lang)
+			if err != nil {
+				t.Fatalf("ToTextIn error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestToTextInGolden describes the same rules in every built-in language, so
+// that the articles, ordinals and list joiners of each one can be reviewed.
+func TestToTextInGolden(t *testing.T) {
+	rules := []string{
+		"FREQ=WEEKLY;BYDAY=MO,WE",
+		"FREQ=MONTHLY;BYDAY=+2TU,-1FR",
+		"FREQ=MONTHLY;BYMONTHDAY=1,15",
+		"FREQ=MONTHLY;BYMONTHDAY=-1",
+		"FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30;COUNT=5",
+		"FREQ=WEEKLY;UNTIL=19980101T080000Z",
+	}
+	set, err := StrToRRuleSet("RRULE:FREQ=WEEKLY;BYDAY=FR\nEXDATE:20231201T090000Z,20231208T090000Z,20231215T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	var tests = []struct {
+		lang     language.Tag
+		expected []string
+	}{
+		{
+			lang: language.English,
+			expected: []string{
+				"every week on Monday, Wednesday",
+				"every month on the 2nd Tuesday and last Friday",
+				"every month on the 1st and 15th",
+				"every month on the last",
+				"every day at 9:30 AM and 5:30 PM for 5 times",
+				"every week until January 1, 1998",
+				"every week on Friday, except December 1, 2023, December 8, 2023 and December 15, 2023",
+			},
+		},
+		{
+			lang: language.Indonesian,
+			expected: []string{
+				"setiap minggu pada hari Senin, Rabu",
+				"setiap bulan pada hari Selasa ke-2 dan Jumat terakhir",
+				"setiap bulan pada hari ke-1 dan ke-15",
+				"setiap bulan pada hari terakhir",
+				"setiap hari pada pukul 09.30 dan 17.30 sebanyak 5 kali",
+				"setiap minggu sampai 1 Januari 1998",
+				"setiap minggu pada hari Jumat, kecuali 1 Desember 2023, 8 Desember 2023 dan 15 Desember 2023",
+			},
+		},
+		{
+			lang: language.German,
+			expected: []string{
+				"jede Woche am Montag, Mittwoch",
+				"jeden Monat am 2. Dienstag und letzten Freitag",
+				"jeden Monat am 1. und 15.",
+				"jeden Monat am letzten Tag",
+				"jeden Tag um 09:30 und 17:30, 5 Mal",
+				"jede Woche, bis zum 1. Januar 1998",
+				"jede Woche am Freitag, außer am 1. Dezember 2023, 8. Dezember 2023 und 15. Dezember 2023",
+			},
+		},
+		{
+			lang: language.French,
+			expected: []string{
+				"chaque semaine le lundi, mercredi",
+				"chaque mois le 2e mardi et le dernier vendredi",
+				"chaque mois le 1er et le 15",
+				"chaque mois le dernier jour",
+				"chaque jour à 09:30 et 17:30, 5 fois",
+				"chaque semaine, jusqu'au 1er janvier 1998",
+				"chaque semaine le vendredi, sauf le 1er décembre 2023, 8 décembre 2023 et 15 décembre 2023",
+			},
+		},
+		{
+			lang: language.Spanish,
+			expected: []string{
+				"cada semana el lunes y miércoles",
+				"cada mes el 2º martes y el último viernes",
+				"cada mes el 1 y el 15",
+				"cada mes el último día",
+				"cada día a las 9:30 y 17:30, 5 veces",
+				"cada semana, hasta el 1 de enero de 1998",
+				"cada semana el viernes, excepto el 1 de diciembre de 2023, 8 de diciembre de 2023 y 15 de diciembre de 2023",
+			},
+		},
+		{
+			lang: language.Portuguese,
+			expected: []string{
+				"todas as semanas às segundas-feiras e às quartas-feiras",
+				"todos os meses na 2ª terça-feira e na última sexta-feira",
+				"todos os meses no dia 1 e no dia 15",
+				"todos os meses no último dia",
+				"todos os dias às 09:30 e 17:30 por 5 vezes",
+				"todas as semanas até 1 de janeiro de 1998",
+				"todas as semanas às sextas-feiras, exceto em 1 de dezembro de 2023, 8 de dezembro de 2023 e 15 de dezembro de 2023",
+			},
+		},
+		{
+			lang: language.Dutch,
+			expected: []string{
+				"elke week op maandag, woensdag",
+				"elke maand op de 2e dinsdag en de laatste vrijdag",
+				"elke maand op de 1e en 15e",
+				"elke maand op de laatste dag",
+				"elke dag om 09:30 en 17:30, 5 keer",
+				"elke week, tot 1 januari 1998",
+				"elke week op vrijdag, behalve op 1 december 2023, 8 december 2023 en 15 december 2023",
+			},
+		},
+		{
+			lang: language.Japanese,
+			expected: []string{
+				"毎週月曜日と水曜日",
+				"毎月第2火曜日と最終金曜日",
+				"毎月1日と15日",
+				"毎月末日",
+				"毎日9:30と17:30、5回",
+				"毎週、1998年1月1日まで",
+				"毎週金曜日、2023年12月1日、2023年12月8日と2023年12月15日を除く",
+			},
+		},
+		{
+			lang: language.Chinese,
+			expected: []string{
+				"每周的星期一和星期三",
+				"每月的第2个星期二和最后一个星期五",
+				"每月的1日和15日",
+				"每月的最后一天",
+				"每天的09:30和17:30，共5次",
+				"每周，直到1998年1月1日",
+				"每周的星期五，除了2023年12月1日、2023年12月8日和2023年12月15日",
+			},
+		},
+	}
+
+	if len(tests) != len(SupportedLanguages()) {
+		t.Errorf("expected a case for each of %v", SupportedLanguages())
+	}
+
+	for _, tt := range tests {
+		for i, rule := range rules {
+			r, err := StrToRRule(rule)
+			if err != nil {
+				t.Fatalf("failed to parse rrule: %v", err)
+			}
+
+			if got, err := r.ToTextIn(tt.lang); err != nil || got != tt.expected[i] {
+				t.Errorf("%s %s: expected %q, got %q, error %v", tt.lang, rule, tt.expected[i], got, err)
+			}
+		}
+
+		if got, err := set.ToTextIn(tt.lang); err != nil || got != tt.expected[len(rules)] {
+			t.Errorf("%s set: expected %q, got %q, error %v", tt.lang, tt.expected[len(rules)], got, err)
+		}
+	}
+}
+
+func TestSetToTextIn(t *testing.T) {
+	set, err := StrToRRuleSet("RRULE:FREQ=WEEKLY;BYDAY=MO\nEXDATE:20231225T090000Z\nRDATE:20240102T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	got, err := set.ToTextIn(language.Japanese)
+	if err != nil {
+		t.Fatalf("ToTextIn error: %v", err)
+	}
+	if want := "毎週月曜日、2023年12月25日を除く、2024年1月2日を追加"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestToTextRelativeIn(t *testing.T) {
+	r, err := StrToRRule("DTSTART:20251006T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=6")
+	if err != nil {
+		t.Fatalf("failed to parse rrule: %v", err)
+	}
+
+	got, err := r.toText(sharedLocaleBundle(), TextOptions{
+		Formatter: LocaleFormatter(language.German),
+		Languages: []string{"de"},
+		Now:       time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("toText error: %v", err)
+	}
+
+	expected := "jede Woche am Montag um 09:00, 6 Mal, nächster Termin am 20. Oktober 2025 (in 3 Tagen), endet nach 4 weiteren Terminen"
+	if got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
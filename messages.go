@@ -0,0 +1,492 @@
+package rrule
+
+import (
+	"errors"
+	"sort"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+// Default English messages used to describe a rule in ToText.
+// Translations are looked up in the i18n bundle by their ID.
+//
+// Each message is a whole phrase whose parts are passed as named template slots,
+// so translations can reorder them and choose articles and prepositions freely.
+// Slots listing names or ordinals, such as {{.DaysOfWeek}}, can be inflected with
+// their In method, e.g. {{.DaysOfWeek.In "accusative"}}, when the TimeFormatter
+// implements InflectingFormatter; their Len method returns the number of items.
+var (
+	msgRule = &i18n.Message{
+		ID:          "Rule",
+		Description: "Describes a rule. Slots: Frequency, Months, Days, YearDays, Weeks, Time and End, each a phrase or empty",
+		Other:       "{{.Frequency}}{{with .Months}} {{.}}{{end}}{{with .Days}} {{.}}{{end}}{{with .YearDays}} {{.}}{{end}}{{with .Weeks}} {{.}}{{end}}{{with .Time}} {{.}}{{end}}{{with .End}} {{.}}{{end}}",
+	}
+
+	msgCompactRule = &i18n.Message{
+		ID:          "CompactRule",
+		Description: "Describes a rule as a short label. Slots: Frequency, Months, Days, YearDays, Weeks, Time and End, each a phrase or empty",
+		Other:       "{{.Frequency}}{{with .Months}} {{.}}{{end}}{{with .Days}} {{.}}{{end}}{{with .YearDays}} {{.}}{{end}}{{with .Weeks}} {{.}}{{end}}{{with .Time}} {{.}}{{end}}{{with .End}}, {{.}}{{end}}",
+	}
+
+	msgVerboseRule = &i18n.Message{
+		ID:          "VerboseRule",
+		Description: "Describes a rule as a full sentence. Slots: Frequency, Months, Days, YearDays, Weeks, Time, Start and End, each a phrase or empty",
+		Other:       "{{.Frequency}}{{with .Months}} {{.}}{{end}}{{with .Days}} {{.}}{{end}}{{with .YearDays}} {{.}}{{end}}{{with .Weeks}} {{.}}{{end}}{{with .Time}} {{.}}{{end}}{{with .Start}}, {{.}}{{end}}{{with .End}}, {{.}}{{end}}",
+	}
+
+	msgSecondly = &i18n.Message{
+		ID:          "Secondly",
+		Description: "Frequency of a secondly rule. Slots: Interval",
+		One:         "every second",
+		Other:       "every {{.Interval}} seconds",
+	}
+
+	msgMinutely = &i18n.Message{
+		ID:          "Minutely",
+		Description: "Frequency of a minutely rule. Slots: Interval",
+		One:         "every minute",
+		Other:       "every {{.Interval}} minutes",
+	}
+
+	msgHourly = &i18n.Message{
+		ID:          "Hourly",
+		Description: "Frequency of an hourly rule. Slots: Interval",
+		One:         "every hour",
+		Other:       "every {{.Interval}} hours",
+	}
+
+	msgDaily = &i18n.Message{
+		ID:          "Daily",
+		Description: "Frequency of a daily rule. Slots: Interval",
+		One:         "every day",
+		Other:       "every {{.Interval}} days",
+	}
+
+	msgDailyOnWeekdays = &i18n.Message{
+		ID:          "DailyOnWeekdays",
+		Description: "Frequency of a daily rule limited to Monday to Friday. Slots: Interval",
+		One:         "every weekday",
+		Other:       "every {{.Interval}} weekdays",
+	}
+
+	msgWeekly = &i18n.Message{
+		ID:          "Weekly",
+		Description: "Frequency of a weekly rule. Slots: Interval",
+		One:         "every week",
+		Other:       "every {{.Interval}} weeks",
+	}
+
+	msgWeeklyOnWeekdays = &i18n.Message{
+		ID:          "WeeklyOnWeekdays",
+		Description: "Frequency of a weekly rule on Monday to Friday. Slots: Interval",
+		One:         "every weekday",
+		Other:       "every {{.Interval}} weeks on weekdays",
+	}
+
+	msgWeeklyOnEveryDay = &i18n.Message{
+		ID:          "WeeklyOnEveryDay",
+		Description: "Frequency of a weekly rule on every day of the week. Slots: Interval",
+		One:         "every day",
+		Other:       "every {{.Interval}} weeks on every day",
+	}
+
+	msgMonthly = &i18n.Message{
+		ID:          "Monthly",
+		Description: "Frequency of a monthly rule. Slots: Interval",
+		One:         "every month",
+		Other:       "every {{.Interval}} months",
+	}
+
+	msgMonthlyInMonths = &i18n.Message{
+		ID:          "MonthlyInMonths",
+		Description: "Frequency of a monthly rule limited to some months. Slots: Interval, Months",
+		One:         "every {{.Months}}",
+		Other:       "every {{.Interval}} months in {{.Months}}",
+	}
+
+	msgYearly = &i18n.Message{
+		ID:          "Yearly",
+		Description: "Frequency of a yearly rule. Slots: Interval",
+		One:         "every year",
+		Other:       "every {{.Interval}} years",
+	}
+
+	msgYearlyInMonths = &i18n.Message{
+		ID:          "YearlyInMonths",
+		Description: "Frequency of a yearly rule limited to some months. Slots: Interval, Months",
+		One:         "every {{.Months}}",
+		Other:       "every {{.Interval}} years {{.Months}}",
+	}
+
+	msgCompactSecondly = &i18n.Message{
+		ID:          "CompactSecondly",
+		Description: "Frequency of a secondly rule in a short label. Slots: Interval",
+		One:         "every second",
+		Other:       "every {{.Interval}} seconds",
+	}
+
+	msgCompactMinutely = &i18n.Message{
+		ID:          "CompactMinutely",
+		Description: "Frequency of a minutely rule in a short label. Slots: Interval",
+		One:         "minutely",
+		Other:       "every {{.Interval}} minutes",
+	}
+
+	msgCompactHourly = &i18n.Message{
+		ID:          "CompactHourly",
+		Description: "Frequency of an hourly rule in a short label. Slots: Interval",
+		One:         "hourly",
+		Other:       "every {{.Interval}} hours",
+	}
+
+	msgCompactDaily = &i18n.Message{
+		ID:          "CompactDaily",
+		Description: "Frequency of a daily rule in a short label. Slots: Interval",
+		One:         "daily",
+		Other:       "every {{.Interval}} days",
+	}
+
+	msgCompactWeekly = &i18n.Message{
+		ID:          "CompactWeekly",
+		Description: "Frequency of a weekly rule in a short label. Slots: Interval",
+		One:         "weekly",
+		Other:       "every {{.Interval}} weeks",
+	}
+
+	msgCompactMonthly = &i18n.Message{
+		ID:          "CompactMonthly",
+		Description: "Frequency of a monthly rule in a short label. Slots: Interval",
+		One:         "monthly",
+		Other:       "every {{.Interval}} months",
+	}
+
+	msgCompactYearly = &i18n.Message{
+		ID:          "CompactYearly",
+		Description: "Frequency of a yearly rule in a short label. Slots: Interval",
+		One:         "yearly",
+		Other:       "every {{.Interval}} years",
+	}
+
+	msgInMonths = &i18n.Message{
+		ID:          "InMonths",
+		Description: "Months of a daily or weekly rule. Slots: Months",
+		Other:       "in {{.Months}}",
+	}
+
+	msgOnDaysOfWeek = &i18n.Message{
+		ID:          "OnDaysOfWeek",
+		Description: "Days of the week of a rule. Slots: DaysOfWeek",
+		Other:       "on {{.DaysOfWeek}}",
+	}
+
+	msgOnTheNthDaysOfWeek = &i18n.Message{
+		ID:          "OnTheNthDaysOfWeek",
+		Description: "Numbered days of the week of a rule, e.g. the 2nd Monday. Slots: NthDaysOfWeek",
+		Other:       "on the {{.NthDaysOfWeek}}",
+	}
+
+	msgOnDaysOfWeekAndTheNth = &i18n.Message{
+		ID:          "OnDaysOfWeekAndTheNth",
+		Description: "Days of the week and numbered days of the week of a rule. Slots: DaysOfWeek, NthDaysOfWeek",
+		Other:       "on {{.DaysOfWeek}} and on the {{.NthDaysOfWeek}}",
+	}
+
+	msgOnWeekdays = &i18n.Message{
+		ID:          "OnWeekdays",
+		Description: "Days of a rule limited to Monday to Friday",
+		Other:       "on weekdays",
+	}
+
+	msgOnTheMonthDays = &i18n.Message{
+		ID:          "OnTheMonthDays",
+		Description: "Days of the month of a rule. Slots: MonthDays",
+		Other:       "on the {{.MonthDays}}",
+	}
+
+	msgOnDaysOfWeekTheMonthDays = &i18n.Message{
+		ID:          "OnDaysOfWeekTheMonthDays",
+		Description: "Days of the week falling on some days of the month. Slots: DaysOfWeek, MonthDays",
+		Other:       "on {{.DaysOfWeek}} the {{.MonthDays}}",
+	}
+
+	msgOnTheYearDays = &i18n.Message{
+		ID:          "OnTheYearDays",
+		Description: "Days of the year of a rule. Slots: YearDays",
+		Other:       "on the {{.YearDays}} day",
+	}
+
+	msgInWeeks = &i18n.Message{
+		ID:          "InWeeks",
+		Description: "Week numbers of a rule, counted by the number of weeks. Slots: Weeks",
+		One:         "in week {{.Weeks}}",
+		Other:       "in weeks {{.Weeks}}",
+	}
+
+	msgAtTimes = &i18n.Message{
+		ID:          "AtTimes",
+		Description: "Times of day of a rule. Slots: Times",
+		Other:       "at {{.Times}}",
+	}
+
+	msgUntilDate = &i18n.Message{
+		ID:          "UntilDate",
+		Description: "End of a rule. Slots: Date",
+		Other:       "until {{.Date}}",
+	}
+
+	msgTimeCount = &i18n.Message{
+		ID:    "TimeCount",
+		One:   "for {{.Count}} time",
+		Two:   "for {{.Count}} times",
+		Few:   "for {{.Count}} times",
+		Many:  "for {{.Count}} times",
+		Other: "for {{.Count}} times",
+	}
+
+	msgCountTimes = &i18n.Message{
+		ID:          "CountTimes",
+		Description: "End of a rule in a short label or a full sentence. Slots: Count",
+		One:         "once",
+		Other:       "{{.Count}} times",
+	}
+
+	msgStarting = &i18n.Message{
+		ID:          "Starting",
+		Description: "Start of a rule in a full sentence. Slots: Date",
+		Other:       "starting {{.Date}}",
+	}
+
+	msgStartingInZone = &i18n.Message{
+		ID:          "StartingInZone",
+		Description: "Start of a rule in a full sentence, outside of UTC. Slots: Date, TimeZone",
+		Other:       "starting {{.Date}} ({{.TimeZone}})",
+	}
+
+	msgStatus = &i18n.Message{
+		ID:          "Status",
+		Description: "Describes a rule or set relative to a reference time. Slots: Description, Next and Remaining, each a phrase or empty",
+		Other:       "{{.Description}}{{with .Next}}, {{.}}{{end}}{{with .Remaining}}, {{.}}{{end}}",
+	}
+
+	msgNextOn = &i18n.Message{
+		ID:          "NextOn",
+		Description: "Next occurrence after the reference time. Slots: Date, Relative",
+		Other:       "next on {{.Date}} ({{.Relative}})",
+	}
+
+	msgEndsAfter = &i18n.Message{
+		ID:          "EndsAfter",
+		Description: "Occurrences left from the reference time on. Slots: Count",
+		One:         "ends after {{.Count}} more time",
+		Other:       "ends after {{.Count}} more times",
+	}
+
+	msgEndsOn = &i18n.Message{
+		ID:          "EndsOn",
+		Description: "End of a rule after the reference time. Slots: Date, Relative",
+		Other:       "ends on {{.Date}} ({{.Relative}})",
+	}
+
+	msgEnded = &i18n.Message{
+		ID:          "Ended",
+		Description: "A rule or set without occurrences left after the reference time",
+		Other:       "ended",
+	}
+
+	msgToday = &i18n.Message{
+		ID:          "Today",
+		Description: "A date on the day of the reference time",
+		Other:       "today",
+	}
+
+	msgTomorrow = &i18n.Message{
+		ID:          "Tomorrow",
+		Description: "A date on the day after the reference time",
+		Other:       "tomorrow",
+	}
+
+	msgInDays = &i18n.Message{
+		ID:          "InDays",
+		Description: "A date some days after the reference time. Slots: Count",
+		One:         "in {{.Count}} day",
+		Other:       "in {{.Count}} days",
+	}
+
+	msgAnd = &i18n.Message{
+		ID:          "And",
+		Description: "Used for final delimiter in list",
+		Other:       "and",
+	}
+
+	msgOr = &i18n.Message{
+		ID:          "Or",
+		Description: "Used for final delimiter in list",
+		Other:       "or",
+	}
+
+	msgDaysOfWeekAnd = &i18n.Message{
+		ID:          "DaysOfWeekAnd",
+		Description: "Final delimiter of the days of the week of a standard or compact description, or a comma to only separate them with ListDelimiter",
+		Other:       ",",
+	}
+
+	msgListDelimiter = &i18n.Message{
+		ID:          "ListDelimiter",
+		Description: "Delimiter between the items of a list. The final delimiter is surrounded by spaces unless it ends without a space, as in languages written without spaces",
+		Other:       ", ",
+	}
+
+	msgSet = &i18n.Message{
+		ID:          "Set",
+		Description: "Describes a set. Slots: Rule, Exceptions and Additions, each a phrase or empty",
+		Other:       "{{.Rule}}{{with .Exceptions}}{{if $.Rule}}, {{end}}{{.}}{{end}}{{with .Additions}}{{if or $.Rule $.Exceptions}}, {{end}}{{.}}{{end}}",
+	}
+
+	msgOnTheDates = &i18n.Message{
+		ID:          "OnTheDates",
+		Description: "Dates of a set without rule. Slots: Dates",
+		Other:       "on {{.Dates}}",
+	}
+
+	msgExceptDates = &i18n.Message{
+		ID:          "ExceptDates",
+		Description: "Dates excluded from a set. Slots: Dates",
+		Other:       "except {{.Dates}}",
+	}
+
+	msgPlusDates = &i18n.Message{
+		ID:          "PlusDates",
+		Description: "Dates added to a set. Slots: Dates",
+		Other:       "plus {{.Dates}}",
+	}
+
+	msgOtherDates = &i18n.Message{
+		ID:    "OtherDates",
+		One:   "{{.Count}} other date",
+		Two:   "{{.Count}} other dates",
+		Few:   "{{.Count}} other dates",
+		Many:  "{{.Count}} other dates",
+		Other: "{{.Count}} other dates",
+	}
+
+	msgOtherExceptions = &i18n.Message{
+		ID:    "OtherExceptions",
+		One:   "{{.Count}} other exception",
+		Two:   "{{.Count}} other exceptions",
+		Few:   "{{.Count}} other exceptions",
+		Many:  "{{.Count}} other exceptions",
+		Other: "{{.Count}} other exceptions",
+	}
+)
+
+// compactFrequencies maps the frequency messages to those of the compact style.
+var compactFrequencies = map[*i18n.Message]*i18n.Message{
+	msgSecondly: msgCompactSecondly,
+	msgMinutely: msgCompactMinutely,
+	msgHourly:   msgCompactHourly,
+	msgDaily:    msgCompactDaily,
+	msgWeekly:   msgCompactWeekly,
+	msgMonthly:  msgCompactMonthly,
+	msgYearly:   msgCompactYearly,
+}
+
+// textMessages lists every message used by ToText.
+var textMessages = []*i18n.Message{
+	msgRule,
+	msgCompactRule,
+	msgVerboseRule,
+	msgSecondly,
+	msgMinutely,
+	msgHourly,
+	msgDaily,
+	msgDailyOnWeekdays,
+	msgWeekly,
+	msgWeeklyOnWeekdays,
+	msgWeeklyOnEveryDay,
+	msgMonthly,
+	msgMonthlyInMonths,
+	msgYearly,
+	msgYearlyInMonths,
+	msgCompactSecondly,
+	msgCompactMinutely,
+	msgCompactHourly,
+	msgCompactDaily,
+	msgCompactWeekly,
+	msgCompactMonthly,
+	msgCompactYearly,
+	msgInMonths,
+	msgOnDaysOfWeek,
+	msgOnTheNthDaysOfWeek,
+	msgOnDaysOfWeekAndTheNth,
+	msgOnWeekdays,
+	msgOnTheMonthDays,
+	msgOnDaysOfWeekTheMonthDays,
+	msgOnTheYearDays,
+	msgInWeeks,
+	msgAtTimes,
+	msgUntilDate,
+	msgTimeCount,
+	msgCountTimes,
+	msgStarting,
+	msgStartingInZone,
+	msgStatus,
+	msgNextOn,
+	msgEndsAfter,
+	msgEndsOn,
+	msgEnded,
+	msgToday,
+	msgTomorrow,
+	msgInDays,
+	msgAnd,
+	msgOr,
+	msgDaysOfWeekAnd,
+	msgListDelimiter,
+	msgSet,
+	msgOnTheDates,
+	msgExceptDates,
+	msgPlusDates,
+	msgOtherDates,
+	msgOtherExceptions,
+}
+
+// DefaultMessages returns a copy of the English default messages used by ToText,
+// sorted by ID. They are the source of active.en.toml and of new translations,
+// see the rrule-i18n command.
+func DefaultMessages() []*i18n.Message {
+	msgs := make([]*i18n.Message, len(textMessages))
+	for i, msg := range textMessages {
+		msg := *msg
+		msgs[i] = &msg
+	}
+	sort.Slice(msgs, func(i, j int) bool { return msgs[i].ID < msgs[j].ID })
+
+	return msgs
+}
+
+// MissingMessages returns the sorted IDs of the ToText messages that bundle has no
+// translation for in lang. Messages the bundle would only render by falling back to
+// another language count as missing.
+// English never misses a message since the defaults are English.
+func MissingMessages(bundle *i18n.Bundle, lang language.Tag) []string {
+	base, _ := lang.Base()
+	if english, _ := language.English.Base(); base == english {
+		return nil
+	}
+
+	loc := i18n.NewLocalizer(bundle, lang.String())
+
+	var missing []string
+	for _, msg := range textMessages {
+		// Templates are executed without their slots here, so only a missing
+		// message, not a template error, counts.
+		var notFound *i18n.MessageNotFoundErr
+		_, tag, err := loc.LocalizeWithTag(&i18n.LocalizeConfig{MessageID: msg.ID})
+		if tagBase, _ := tag.Base(); errors.As(err, &notFound) || tagBase != base {
+			missing = append(missing, msg.ID)
+		}
+	}
+	sort.Strings(missing)
+
+	return missing
+}
@@ -0,0 +1,58 @@
+package rrule
+
+import (
+	"testing"
+
+	"github.com/BurntSushi/toml"
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
+)
+
+func TestMissingMessages(t *testing.T) {
+	bundle := i18n.NewBundle(language.English)
+	bundle.RegisterUnmarshalFunc("toml", toml.Unmarshal)
+	bundle.MustLoadMessageFile("active.id.toml")
+	bundle.MustAddMessages(language.German, &i18n.Message{ID: "Daily", One: "jeden Tag", Other: "alle {{.Interval}} Tage"})
+
+	if got := MissingMessages(bundle, language.English); got != nil {
+		t.Errorf("expected no missing English messages, got %v", got)
+	}
+
+	if got := MissingMessages(bundle, language.Indonesian); got != nil {
+		t.Errorf("expected no missing Indonesian messages, got %v", got)
+	}
+
+	got := MissingMessages(bundle, language.German)
+	if len(got) != len(textMessages)-1 {
+		t.Errorf("expected %d missing German messages, got %v", len(textMessages)-1, got)
+	}
+	for _, id := range got {
+		if id == "Daily" {
+			t.Errorf("expected %q to be translated, got %v", id, got)
+		}
+	}
+
+	if got := MissingMessages(bundle, language.French); len(got) != len(textMessages) {
+		t.Errorf("expected every French message to be missing, got %v", got)
+	}
+}
+
+func TestDefaultMessages(t *testing.T) {
+	msgs := DefaultMessages()
+	if len(msgs) != len(textMessages) {
+		t.Fatalf("expected %d messages, got %d", len(textMessages), len(msgs))
+	}
+
+	for i := 1; i < len(msgs); i++ {
+		if msgs[i-1].ID >= msgs[i].ID {
+			t.Errorf("expected messages sorted by ID, got %q before %q", msgs[i-1].ID, msgs[i].ID)
+		}
+	}
+
+	msgs[0].Other = "changed"
+	for _, msg := range textMessages {
+		if msg.Other == "changed" {
+			t.Errorf("expected a copy, %q changed", msg.ID)
+		}
+	}
+}
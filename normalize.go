@@ -0,0 +1,84 @@
+package rrule
+
+import "time"
+
+// Normalize returns the canonical minimal form of the options, which generates
+// the same occurrences, so that equal rules have equal RRuleString values:
+//
+//   - BYxxx lists are sorted and deduplicated, and nth weekdays of weekly or
+//     finer rules, which are ignored, lose their n.
+//   - INTERVAL=1, a negative COUNT and a WKST that numbers no week and starts no
+//     period that matters are reset to their defaults.
+//   - Parts implied by DTSTART or by the other parts are dropped, e.g. a BYMONTH
+//     equal to the month of DTSTART in a yearly rule, or a BYDAY listing every
+//     weekday along with BYMONTHDAY.
+//
+// Without DTSTART, which then defaults to the time the rule is built, no part
+// implied by DTSTART is dropped. FREQ is kept, even when another frequency would
+// generate the same occurrences, see Equivalent.
+func (option ROption) Normalize() ROption {
+	n := option
+	if n.Interval <= 1 {
+		n.Interval = 0
+	}
+	if n.Count < 0 {
+		n.Count = 0
+	}
+	n.Dtstart = n.Dtstart.Truncate(time.Second)
+	n.Until = n.Until.Truncate(time.Second)
+
+	n.Bysetpos = uniqueInts(n.Bysetpos)
+	n.Bymonth = uniqueInts(n.Bymonth)
+	n.Bymonthday = uniqueInts(n.Bymonthday)
+	n.Byyearday = uniqueInts(n.Byyearday)
+	n.Byweekno = uniqueInts(n.Byweekno)
+	n.Byhour = uniqueInts(n.Byhour)
+	n.Byminute = uniqueInts(n.Byminute)
+	n.Bysecond = uniqueInts(n.Bysecond)
+	n.Byeaster = uniqueInts(n.Byeaster)
+
+	weekdays := make([]Weekday, 0, len(n.Byweekday))
+	for _, wday := range n.Byweekday {
+		if n.Freq > MONTHLY {
+			wday.n = 0
+		}
+		weekdays = append(weekdays, wday)
+	}
+	n.Byweekday = uniqueWeekdays(weekdays)
+
+	drops := []func(o *ROption){
+		func(o *ROption) { o.Wkst = MO },
+	}
+	if !n.Dtstart.IsZero() {
+		drops = append(drops,
+			func(o *ROption) { o.Bymonthday = nil },
+			func(o *ROption) { o.Byweekday = nil },
+			func(o *ROption) { o.Bymonth = nil },
+			func(o *ROption) { o.Byhour = nil },
+			func(o *ROption) { o.Byminute = nil },
+			func(o *ROption) { o.Bysecond = nil },
+		)
+	}
+
+	// Dropping a part may make another one redundant, e.g. BYMONTH once
+	// BYMONTHDAY is implied by DTSTART, so drop until nothing changes.
+	want := buildRRule(n)
+	for dropped := true; dropped; {
+		dropped = false
+		for _, drop := range drops {
+			candidate := n
+			drop(&candidate)
+			if candidate.RRuleString() == n.RRuleString() {
+				continue
+			}
+
+			got := buildRRule(candidate)
+			if equalOptions(got.canonical(), want.canonical()) {
+				n = candidate
+				dropped = true
+			}
+		}
+	}
+
+	return n
+}
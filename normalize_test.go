@@ -0,0 +1,65 @@
+package rrule
+
+import "testing"
+
+func TestNormalize(t *testing.T) {
+	var tests = []struct {
+		rule     string
+		expected string
+	}{
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;INTERVAL=1;WKST=SU;BYMONTH=9;BYMONTHDAY=2",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY",
+		},
+		{
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=9;BYDAY=TU",
+			"DTSTART:19970902T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=9;BYDAY=TU",
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=FR,MO,MO,+2WE",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR",
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO;BYHOUR=9;BYMINUTE=0;BYSECOND=0",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY",
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=SU,TU",
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=SU;BYDAY=TU,SU",
+		},
+		{
+			"DTSTART:20240113T090000Z\nRRULE:FREQ=MONTHLY;COUNT=-1;BYMONTHDAY=13,13;BYDAY=MO,TU,WE,TH,FR,SA,SU",
+			"DTSTART:20240113T090000Z\nRRULE:FREQ=MONTHLY",
+		},
+		{
+			"DTSTART:20240113T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=-1,13,1;BYSETPOS=-1,1,1",
+			"DTSTART:20240113T090000Z\nRRULE:FREQ=MONTHLY;BYSETPOS=-1,1;BYMONTHDAY=-1,1,13",
+		},
+		{
+			"FREQ=WEEKLY;WKST=SU;BYDAY=WE,MO;BYHOUR=9",
+			"FREQ=WEEKLY;BYDAY=MO,WE;BYHOUR=9",
+		},
+	}
+
+	for _, tt := range tests {
+		option, err := StrToROption(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		normalized := option.Normalize()
+		if got := normalized.String(); got != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.rule, tt.expected, got)
+		}
+
+		if option.Dtstart.IsZero() {
+			continue
+		}
+
+		a, _ := NewRRule(*option)
+		b, _ := NewRRule(normalized)
+		if !Equivalent(a, b) {
+			t.Errorf("%q: expected %q to be equivalent", tt.rule, normalized.String())
+		}
+	}
+}
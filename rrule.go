@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"sync"
 	"time"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
@@ -136,8 +137,19 @@ type RRule struct {
 	bysecond                []int
 	byeaster                []int
 	timeset                 []time.Time
-	len                     int
-	i18n                    *i18n.Bundle
+	// found is shared by the iterators of the rule, which may run concurrently.
+	found *found
+	i18n  *i18n.Bundle
+}
+
+// found holds what iterating a rule found out about it.
+type found struct {
+	mu sync.Mutex
+	// len is the number of occurrences once an iteration completed, else -1.
+	len int
+	err error
+	// countEnd is the last occurrence allowed by COUNT, once looked up.
+	countEnd time.Time
 }
 
 // NewRRule construct a new RRule instance
@@ -164,6 +176,8 @@ func NewRRuleWithi18n(arg ROption, bundle *i18n.Bundle) (*RRule, error) {
 func buildRRule(arg ROption) RRule {
 	r := RRule{}
 	r.OrigOptions = arg
+	// The number of occurrences is known once an iteration completes.
+	r.found = &found{len: -1}
 	// FREQ default to YEARLY
 	r.freq = arg.Freq
 
@@ -512,6 +526,26 @@ func (info *iterInfo) rebuild(year int, month time.Month) {
 	info.lastmonth = month
 }
 
+// excluded reports whether the BYxxx rules exclude the day i of the current year.
+func (info *iterInfo) excluded(i int) bool {
+	r := info.rrule
+	return len(r.bymonth) != 0 && !contains(r.bymonth, info.mmask[i]) ||
+		len(r.byweekno) != 0 && info.wnomask[i] == 0 ||
+		len(r.byweekday) != 0 && !contains(r.byweekday, info.wdaymask[i]) ||
+		len(info.nwdaymask) != 0 && info.nwdaymask[i] == 0 ||
+		len(r.byeaster) != 0 && info.eastermask[i] == 0 ||
+		(len(r.bymonthday) != 0 || len(r.bynmonthday) != 0) &&
+			!contains(r.bymonthday, info.mdaymask[i]) &&
+			!contains(r.bynmonthday, info.nmdaymask[i]) ||
+		len(r.byyearday) != 0 &&
+			(i < info.yearlen &&
+				!contains(r.byyearday, i+1) &&
+				!contains(r.byyearday, -info.yearlen+i) ||
+				i >= info.yearlen &&
+					!contains(r.byyearday, i+1-info.yearlen) &&
+					!contains(r.byyearday, -info.nextyearlen+i-info.yearlen))
+}
+
 func (info *iterInfo) calcDaySet(freq Frequency, year int, month time.Month, day int) (start, end int) {
 	switch freq {
 	case YEARLY:
@@ -595,6 +629,10 @@ type rIterator struct {
 	remain   reusingRemainSlice
 	finished bool
 	dayset   []optInt
+	// empty counts the periods in a row without occurrence.
+	empty int
+	// seeked is set when the iteration starts after the first period.
+	seeked bool
 }
 
 func (iterator *rIterator) generate() {
@@ -613,22 +651,7 @@ func (iterator *rIterator) generate() {
 
 		// Do the "hard" work ;-)
 		for dayIndex, day := range dayset {
-			i := day.Int
-			if len(r.bymonth) != 0 && !contains(r.bymonth, iterator.ii.mmask[i]) ||
-				len(r.byweekno) != 0 && iterator.ii.wnomask[i] == 0 ||
-				len(r.byweekday) != 0 && !contains(r.byweekday, iterator.ii.wdaymask[i]) ||
-				len(iterator.ii.nwdaymask) != 0 && iterator.ii.nwdaymask[i] == 0 ||
-				len(r.byeaster) != 0 && iterator.ii.eastermask[i] == 0 ||
-				(len(r.bymonthday) != 0 || len(r.bynmonthday) != 0) &&
-					!contains(r.bymonthday, iterator.ii.mdaymask[i]) &&
-					!contains(r.bynmonthday, iterator.ii.nmdaymask[i]) ||
-				len(r.byyearday) != 0 &&
-					(i < iterator.ii.yearlen &&
-						!contains(r.byyearday, i+1) &&
-						!contains(r.byyearday, -iterator.ii.yearlen+i) ||
-						i >= iterator.ii.yearlen &&
-							!contains(r.byyearday, i+1-iterator.ii.yearlen) &&
-							!contains(r.byyearday, -iterator.ii.nextyearlen+i-iterator.ii.yearlen)) {
+			if iterator.ii.excluded(day.Int) {
 				dayset[dayIndex].Defined = false
 				filtered = true
 			}
@@ -679,8 +702,7 @@ func (iterator *rIterator) generate() {
 			sort.Sort(timeSlice(poslist))
 			for _, res := range poslist {
 				if !r.until.IsZero() && res.After(r.until) {
-					r.len = iterator.total
-					iterator.finished = true
+					iterator.finish()
 					return
 				} else if !res.Before(r.dtstart) {
 					iterator.total++
@@ -688,8 +710,7 @@ func (iterator *rIterator) generate() {
 					if iterator.count != 0 {
 						iterator.count--
 						if iterator.count == 0 {
-							r.len = iterator.total
-							iterator.finished = true
+							iterator.finish()
 							return
 						}
 					}
@@ -720,8 +741,7 @@ func (iterator *rIterator) generate() {
 					}
 
 					if !r.until.IsZero() && res.After(r.until) {
-						r.len = iterator.total
-						iterator.finished = true
+						iterator.finish()
 						return
 					} else if !res.Before(r.dtstart) {
 						iterator.total++
@@ -729,8 +749,7 @@ func (iterator *rIterator) generate() {
 						if iterator.count != 0 {
 							iterator.count--
 							if iterator.count == 0 {
-								r.len = iterator.total
-								iterator.finished = true
+								iterator.finish()
 								return
 							}
 						}
@@ -738,13 +757,21 @@ func (iterator *rIterator) generate() {
 				}
 			}
 		}
+		if iterator.remain.Len() != 0 {
+			iterator.empty = 0
+		} else if iterator.empty++; iterator.empty > gregorianCycle[r.freq] && len(r.byeaster) == 0 {
+			// The date of Easter does not repeat with the Gregorian calendar,
+			// so only rules without BYEASTER are known to stay empty.
+			iterator.abort()
+			return
+		}
+
 		// Handle frequency and interval
 		fixday := false
 		if r.freq == YEARLY {
 			iterator.year += r.interval
 			if iterator.year > MAXYEAR {
-				r.len = iterator.total
-				iterator.finished = true
+				iterator.finish()
 				return
 			}
 			iterator.ii.rebuild(iterator.year, iterator.month)
@@ -759,8 +786,7 @@ func (iterator *rIterator) generate() {
 					iterator.year--
 				}
 				if iterator.year > MAXYEAR {
-					r.len = iterator.total
-					iterator.finished = true
+					iterator.finish()
 					return
 				}
 			}
@@ -781,7 +807,12 @@ func (iterator *rIterator) generate() {
 				// Jump to one iteration before next day
 				iterator.hour += ((23 - iterator.hour) / r.interval) * r.interval
 			}
-			for {
+			for steps := 0; ; steps++ {
+				if steps == 24 {
+					// Every hour of the day reachable with the interval was tried.
+					iterator.abort()
+					return
+				}
 				iterator.hour += r.interval
 				div, mod := divmod(iterator.hour, 24)
 				if div != 0 {
@@ -799,7 +830,12 @@ func (iterator *rIterator) generate() {
 				// Jump to one iteration before next day
 				iterator.minute += ((1439 - (iterator.hour*60 + iterator.minute)) / r.interval) * r.interval
 			}
-			for {
+			for steps := 0; ; steps++ {
+				if steps == 1440 {
+					// Every minute of the day reachable with the interval was tried.
+					iterator.abort()
+					return
+				}
 				iterator.minute += r.interval
 				div, mod := divmod(iterator.minute, 60)
 				if div != 0 {
@@ -823,7 +859,12 @@ func (iterator *rIterator) generate() {
 				// Jump to one iteration before next day
 				iterator.second += (((86399 - (iterator.hour*3600 + iterator.minute*60 + iterator.second)) / r.interval) * r.interval)
 			}
-			for {
+			for steps := 0; ; steps++ {
+				if steps == 86400 {
+					// Every second of the day reachable with the interval was tried.
+					iterator.abort()
+					return
+				}
 				iterator.second += r.interval
 				div, mod := divmod(iterator.second, 60)
 				if div != 0 {
@@ -859,8 +900,7 @@ func (iterator *rIterator) generate() {
 						iterator.month = 1
 						iterator.year++
 						if iterator.year > MAXYEAR {
-							r.len = iterator.total
-							iterator.finished = true
+							iterator.finish()
 							return
 						}
 					}
@@ -872,6 +912,27 @@ func (iterator *rIterator) generate() {
 	}
 }
 
+// abort stops the iteration of a rule that cannot produce any further occurrence.
+func (iterator *rIterator) abort() {
+	found := iterator.ii.rrule.found
+	found.mu.Lock()
+	found.err = ErrNoOccurrences
+	found.mu.Unlock()
+	iterator.finish()
+}
+
+// finish ends the iteration, and records the number of occurrences of the rule
+// when the iteration started at DTSTART.
+func (iterator *rIterator) finish() {
+	if !iterator.seeked {
+		found := iterator.ii.rrule.found
+		found.mu.Lock()
+		found.len = iterator.total
+		found.mu.Unlock()
+	}
+	iterator.finished = true
+}
+
 func (iterator *rIterator) fillDaySetMonotonic(start, end int) {
 	desiredLen := end - start
 
@@ -926,10 +987,76 @@ func (s *reusingRemainSlice) Pop() (ret time.Time, ok bool) {
 
 // Iterator return an iterator for RRule
 func (r *RRule) Iterator() Next {
-	iterator := rIterator{}
-	iterator.year, iterator.month, iterator.day = r.dtstart.Date()
-	iterator.hour, iterator.minute, iterator.second = r.dtstart.Clock()
-	iterator.weekday = toPyWeekday(r.dtstart.Weekday())
+	year, month, day := r.dtstart.Date()
+	hour, minute, second := r.dtstart.Clock()
+	return r.iteratorAt(year, month, day, hour, minute, second).next
+}
+
+// iteratorFrom returns an iterator over the occurrences of the rule from about
+// t: a rule without COUNT starts with the period of t, found by arithmetic as by
+// Contains, and may give a few occurrences before t. A rule with COUNT starts
+// at DTSTART, since its occurrences must be counted.
+func (r *RRule) iteratorFrom(t time.Time) Next {
+	lt := t.In(r.dtstart.Location())
+	if r.count > 0 || !lt.After(r.dtstart) {
+		return r.Iterator()
+	}
+
+	year, month, day := lt.Date()
+	hour, minute, second := r.dtstart.Clock()
+	dtyear, dtmonth, dtday := r.dtstart.Date()
+	switch r.freq {
+	case YEARLY:
+		year -= pymod(year-dtyear, r.interval)
+		month, day = dtmonth, dtday
+	case MONTHLY:
+		months := (year-dtyear)*12 + int(month-dtmonth)
+		months += int(dtmonth) - 1 - pymod(months, r.interval)
+		year, month, day = dtyear+months/12, time.Month(months%12+1), dtday
+	case WEEKLY:
+		// The first period starts at DTSTART, the others on WKST.
+		weekStart := dayNumber(lt) - pymod(toPyWeekday(lt.Weekday())-r.wkst, 7)
+		dtWeekStart := dayNumber(r.dtstart) - pymod(toPyWeekday(r.dtstart.Weekday())-r.wkst, 7)
+		weekStart -= pymod((weekStart-dtWeekStart)/7, r.interval) * 7
+		if weekStart == dtWeekStart {
+			return r.Iterator()
+		}
+		year, month, day = fromDayNumber(weekStart)
+	case DAILY:
+		days := dayNumber(lt) - dayNumber(r.dtstart)
+		year, month, day = fromDayNumber(dayNumber(lt) - pymod(days, r.interval))
+	default:
+		// Start on the day before, whose occurrences a daylight saving time
+		// transition may move to the day of t, at the first time a multiple of
+		// INTERVAL from DTSTART.
+		step := r.interval
+		if r.freq == HOURLY {
+			step *= 3600
+		} else if r.freq == MINUTELY {
+			step *= 60
+		}
+		dtseconds := dayNumber(r.dtstart)*86400 + hour*3600 + minute*60 + second
+		seconds := (dayNumber(lt) - 1) * 86400
+		if seconds <= dtseconds {
+			return r.Iterator()
+		}
+		seconds += pymod(dtseconds-seconds, step)
+		year, month, day = fromDayNumber(seconds / 86400)
+		hour, minute, second = seconds/3600%24, seconds/60%60, seconds%60
+	}
+
+	iterator := r.iteratorAt(year, month, day, hour, minute, second)
+	iterator.seeked = true
+	return iterator.next
+}
+
+// iteratorAt returns an iterator starting with the period of the given date and
+// time, which must be one the iteration from DTSTART goes through.
+func (r *RRule) iteratorAt(year int, month time.Month, day, hour, minute, second int) *rIterator {
+	iterator := &rIterator{}
+	iterator.year, iterator.month, iterator.day = year, month, day
+	iterator.hour, iterator.minute, iterator.second = hour, minute, second
+	iterator.weekday = toPyWeekday(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Weekday())
 
 	iterator.ii = iterInfo{rrule: r}
 	iterator.ii.rebuild(iterator.year, iterator.month)
@@ -946,7 +1073,7 @@ func (r *RRule) Iterator() Next {
 		}
 	}
 	iterator.count = r.count
-	return iterator.next
+	return iterator
 }
 
 // All returns all occurrences of the RRule.
@@ -955,6 +1082,38 @@ func (r *RRule) All() []time.Time {
 	return all(r.Iterator())
 }
 
+// IsFinite reports whether the rule has a bounded number of occurrences, that is
+// whether it has a COUNT or an UNTIL, or never produces an occurrence.
+// The iteration of other rules only stops at MAXYEAR or about 290 years after DTSTART.
+func (r *RRule) IsFinite() bool {
+	return r.count > 0 || !r.OrigOptions.Until.IsZero() || r.IsEmpty()
+}
+
+// Count returns the number of occurrences of a finite rule, or -1 if the rule is
+// not finite, see IsFinite. The occurrences are counted without being stored and
+// the result is kept until the rule changes.
+func (r *RRule) Count() int {
+	if !r.IsFinite() {
+		return -1
+	}
+
+	if n := r.len(); n >= 0 {
+		return n
+	}
+
+	next := r.Iterator()
+	for _, ok := next(); ok; _, ok = next() {
+	}
+	return r.len()
+}
+
+// len returns the number of occurrences found by a completed iteration, or -1.
+func (r *RRule) len() int {
+	r.found.mu.Lock()
+	defer r.found.mu.Unlock()
+	return r.found.len
+}
+
 // Between returns all the occurrences of the RRule between after and before.
 // The inc keyword defines what happens if after and/or before are themselves occurrences.
 // With inc == True, they will be included in the list, if they are found in the recurrence set.
@@ -1002,25 +1161,55 @@ func (r *RRule) Until(ut time.Time) {
 	*r = buildRRule(r.OrigOptions)
 }
 
+// Err returns ErrNoOccurrences if generating the occurrences of the rule was
+// aborted because it cannot produce any further occurrence, nil otherwise.
+func (r *RRule) Err() error {
+	r.found.mu.Lock()
+	defer r.found.mu.Unlock()
+	return r.found.err
+}
+
 // GetUntil gets UNTIL time for rrule
 func (r *RRule) GetUntil() time.Time {
 	return r.until
 }
 
+// ToText returns an English description of the rule, e.g. "every week on Monday".
+// Messages missing from the i18n bundle of the rule fall back to their English default.
 func (r *RRule) ToText() string {
-	if r.i18n == nil {
-		r.i18n = i18n.NewBundle(language.English)
-	}
-	loc := i18n.NewLocalizer(r.i18n, "en-US")
-
-	return newToText(r, loc, defaultFormatter{}).ToString()
+	text, _ := r.ToTextWithOptions(TextOptions{Fallback: true})
+	return text
 }
 
+// ToTextWithCustomFormatter describes the rule in the first of langs found in the
+// i18n bundle of the rule, rendering dates and names with formatter.
+// It returns a *LocalizeError if the bundle lacks a message.
 func (r *RRule) ToTextWithCustomFormatter(formatter TimeFormatter, langs ...string) (string, error) {
 	if r.i18n == nil {
 		return "", errors.New("i18n bundle is required")
 	}
-	loc := i18n.NewLocalizer(r.i18n, langs...)
 
-	return newToText(r, loc, formatter).ToString(), nil
+	return r.ToTextWithOptions(TextOptions{
+		Formatter: formatter,
+		Languages: langs,
+	})
+}
+
+// ToTextWithOptions describes the rule using the i18n bundle of the rule, or the
+// English defaults when it has none.
+func (r *RRule) ToTextWithOptions(opts TextOptions) (string, error) {
+	return r.toText(r.i18n, opts)
+}
+
+// ToTextIn describes the rule in lang with the built-in translations and
+// formatters, see SupportedLanguages. Other languages are described in English.
+func (r *RRule) ToTextIn(lang language.Tag) (string, error) {
+	return r.toText(sharedLocaleBundle(), TextOptions{
+		Formatter: LocaleFormatter(lang),
+		Languages: []string{lang.String()},
+	})
+}
+
+func (r *RRule) toText(bundle *i18n.Bundle, opts TextOptions) (string, error) {
+	return newToText(r, opts.localizer(bundle), opts).ToString()
 }
@@ -4093,3 +4093,89 @@ func iterateNum(iter Next, num int) (last time.Time) {
 	}
 	return last
 }
+
+func TestIsFiniteAndCount(t *testing.T) {
+	var tests = []struct {
+		rule   string
+		finite bool
+		count  int
+	}{
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=DAILY;COUNT=5", true, 5},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=WEEKLY;UNTIL=20000201T090000Z", true, 5},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30;COUNT=3", true, 0},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=2;BYMONTHDAY=30", true, 0},
+		{"DTSTART:99950101T090000Z\nRRULE:FREQ=YEARLY;COUNT=10", true, 5},
+		{"DTSTART:20000101T090000Z\nRRULE:FREQ=DAILY", false, -1},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		if got := r.IsFinite(); got != tt.finite {
+			t.Errorf("%q: expected IsFinite %v, got %v", tt.rule, tt.finite, got)
+		}
+		if got := r.Count(); got != tt.count {
+			t.Errorf("%q: expected Count %d, got %d", tt.rule, tt.count, got)
+		}
+		if got := r.Count(); got != tt.count {
+			t.Errorf("%q: expected Count %d again, got %d", tt.rule, tt.count, got)
+		}
+	}
+}
+
+func TestCountAfterChange(t *testing.T) {
+	r, _ := NewRRule(ROption{
+		Freq:    DAILY,
+		Dtstart: time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC),
+		Until:   time.Date(2000, 1, 10, 9, 0, 0, 0, time.UTC),
+	})
+	if got := r.Count(); got != 10 {
+		t.Errorf("expected 10 occurrences, got %d", got)
+	}
+
+	r.Until(time.Date(2000, 1, 3, 9, 0, 0, 0, time.UTC))
+	if got := r.Count(); got != 3 {
+		t.Errorf("expected 3 occurrences after changing UNTIL, got %d", got)
+	}
+}
+
+func TestIteratorFrom(t *testing.T) {
+	rules := []string{
+		"DTSTART:20150101T000000Z\nRRULE:FREQ=YEARLY;INTERVAL=3;BYMONTH=2;BYMONTHDAY=29",
+		"DTSTART:20150131T100000Z\nRRULE:FREQ=MONTHLY;INTERVAL=5;BYSETPOS=-1;BYDAY=MO,FR",
+		"DTSTART:20150101T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=3;BYDAY=SU,TU;WKST=SU",
+		"DTSTART:20150101T090000Z\nRRULE:FREQ=DAILY;INTERVAL=4;BYHOUR=9,21",
+		"DTSTART;TZID=America/New_York:20150101T013000\nRRULE:FREQ=HOURLY;INTERVAL=5",
+		"DTSTART;TZID=America/New_York:20150101T013000\nRRULE:FREQ=MINUTELY;INTERVAL=7;BYHOUR=1,2,3",
+		"DTSTART:20150101T000001Z\nRRULE:FREQ=SECONDLY;INTERVAL=13;BYMINUTE=0",
+		"DTSTART:20150101T000000Z\nRRULE:FREQ=HOURLY;COUNT=20000",
+	}
+	froms := []time.Time{
+		time.Date(2014, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 10, 6, 30, 0, 0, time.UTC),
+		time.Date(2024, 11, 3, 5, 59, 59, 0, time.UTC),
+		time.Date(2031, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+
+	for _, rule := range rules {
+		r, err := StrToRRule(rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", rule, err)
+		}
+		for _, from := range froms {
+			expected, got := r.Iterator(), r.iteratorFrom(from)
+			dt := from
+			for i := 0; i < 5; i++ {
+				e, g := after(expected, dt, true), after(got, dt, true)
+				if !g.Equal(e) {
+					t.Errorf("%q from %v: expected %v, got %v", rule, from, e, g)
+					break
+				}
+				dt = e.Add(time.Second)
+			}
+		}
+	}
+}
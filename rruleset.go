@@ -3,9 +3,13 @@
 package rrule
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"time"
+
+	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
 )
 
 // Set allows more complex recurrence setups, mixing multiple rules, dates, exclusion rules, and exclusion dates
@@ -132,13 +136,33 @@ func addGenList(genList *[]genItem, next Next) {
 
 // Iterator returns an iterator for rrule.Set
 func (set *Set) Iterator() (next func() (time.Time, bool)) {
+	var rule Next
+	if set.rrule != nil {
+		rule = set.rrule.Iterator()
+	}
+	return set.iterator(rule)
+}
+
+// iteratorFrom returns an iterator over the occurrences of the set from about
+// t, see RRule.iteratorFrom.
+func (set *Set) iteratorFrom(t time.Time) Next {
+	var rule Next
+	if set.rrule != nil {
+		rule = set.rrule.iteratorFrom(t)
+	}
+	return set.iterator(rule)
+}
+
+// iterator returns an iterator merging the RDATE values with the occurrences
+// given by rule, which is nil without RRULE.
+func (set *Set) iterator(rule Next) Next {
 	rlist := []genItem{}
 	exlist := []genItem{}
 
 	sort.Sort(timeSlice(set.rdate))
 	addGenList(&rlist, timeSliceIterator(set.rdate))
-	if set.rrule != nil {
-		addGenList(&rlist, set.rrule.Iterator())
+	if rule != nil {
+		addGenList(&rlist, rule)
 	}
 	sort.Sort(genItemSlice(rlist))
 
@@ -180,6 +204,28 @@ func (set *Set) All() []time.Time {
 	return all(set.Iterator())
 }
 
+// IsFinite reports whether the set has a bounded number of occurrences, that is
+// whether it has no RRULE or a finite one, see RRule.IsFinite.
+func (set *Set) IsFinite() bool {
+	return set.rrule == nil || set.rrule.IsFinite()
+}
+
+// Count returns the number of occurrences of a finite set, or -1 if the set is
+// not finite, see IsFinite. The occurrences are counted without being stored.
+func (set *Set) Count() int {
+	if !set.IsFinite() {
+		return -1
+	}
+
+	count := 0
+	next := set.Iterator()
+	for _, ok := next(); ok; _, ok = next() {
+		count++
+	}
+
+	return count
+}
+
 // Between returns all the occurrences of the rrule between after and before.
 // The inc keyword defines what happens if after and/or before are themselves occurrences.
 // With inc == True, they will be included in the list, if they are found in the recurrence set.
@@ -205,3 +251,61 @@ func (set *Set) Before(dt time.Time, inc bool) time.Time {
 func (set *Set) After(dt time.Time, inc bool) time.Time {
 	return after(set.Iterator(), dt, inc)
 }
+
+// ToText returns an English description of the set: its rule, followed by
+// the excluded and the additionally included dates, e.g.
+// "every week on Monday, except December 25, 2023, plus January 2, 2024".
+func (set *Set) ToText() string {
+	text, _ := set.ToTextWithOptions(TextOptions{Fallback: true})
+	return text
+}
+
+// ToTextWithCustomFormatter describes the set in the first of langs found in the
+// i18n bundle of its RRULE, rendering dates and names with formatter.
+// It returns a *LocalizeError if the bundle lacks a message.
+// A set without RRULE has no bundle: its dates are described with the English
+// defaults, still rendered with formatter.
+func (set *Set) ToTextWithCustomFormatter(formatter TimeFormatter, langs ...string) (string, error) {
+	if set.rrule == nil {
+		return set.ToTextWithOptions(TextOptions{
+			Formatter: formatter,
+			Languages: langs,
+			Fallback:  true,
+		})
+	}
+	if set.i18nBundle() == nil {
+		return "", errors.New("i18n bundle is required")
+	}
+
+	return set.ToTextWithOptions(TextOptions{
+		Formatter: formatter,
+		Languages: langs,
+	})
+}
+
+// ToTextWithOptions describes the set using the i18n bundle of its RRULE, or the
+// English defaults when it has none.
+func (set *Set) ToTextWithOptions(opts TextOptions) (string, error) {
+	return set.toText(set.i18nBundle(), opts)
+}
+
+// ToTextIn describes the set in lang with the built-in translations and
+// formatters, see SupportedLanguages. Other languages are described in English.
+func (set *Set) ToTextIn(lang language.Tag) (string, error) {
+	return set.toText(sharedLocaleBundle(), TextOptions{
+		Formatter: LocaleFormatter(lang),
+		Languages: []string{lang.String()},
+	})
+}
+
+func (set *Set) toText(bundle *i18n.Bundle, opts TextOptions) (string, error) {
+	return newSetToText(set, opts.localizer(bundle), opts).ToString()
+}
+
+func (set *Set) i18nBundle() *i18n.Bundle {
+	if set.rrule == nil {
+		return nil
+	}
+
+	return set.rrule.i18n
+}
@@ -381,3 +381,26 @@ func TestRuleSetChangeDTStartTimezoneRespected(t *testing.T) {
 		}
 	}
 }
+
+func TestSetIsFiniteAndCount(t *testing.T) {
+	set := Set{}
+	set.RDate(time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC))
+	set.RDate(time.Date(2000, 1, 5, 9, 0, 0, 0, time.UTC))
+	if !set.IsFinite() || set.Count() != 2 {
+		t.Errorf("expected 2 dates, got finite %v, count %d", set.IsFinite(), set.Count())
+	}
+
+	r, _ := NewRRule(ROption{Freq: DAILY, Count: 5, Dtstart: time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC)})
+	set.RRule(r)
+	set.ExDate(time.Date(2000, 1, 2, 9, 0, 0, 0, time.UTC))
+	set.RDate(time.Date(2000, 1, 10, 9, 0, 0, 0, time.UTC))
+	if got := set.Count(); got != 5 {
+		t.Errorf("expected 5 occurrences, got %d", got)
+	}
+
+	r, _ = NewRRule(ROption{Freq: DAILY, Dtstart: time.Date(2000, 1, 1, 9, 0, 0, 0, time.UTC)})
+	set.RRule(r)
+	if set.IsFinite() || set.Count() != -1 {
+		t.Errorf("expected an infinite set, got finite %v, count %d", set.IsFinite(), set.Count())
+	}
+}
@@ -0,0 +1,282 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNotShiftable is returned by Shift when the rule cannot generate the moved
+// occurrences while keeping its pattern.
+var ErrNotShiftable = errors.New("rrule: pattern cannot be shifted")
+
+// Shift returns a copy of the rule moved by d, as when the first occurrence of
+// a series is dragged to another day or time. DTSTART and UNTIL are moved by d
+// on the wall clock, so that whole days of d move the series by calendar days
+// across daylight saving time transitions.
+//
+// The parts of the rule follow: weekdays of BYDAY and days of BYMONTHDAY,
+// BYYEARDAY and BYEASTER move by the days of the shift, and BYHOUR, BYMINUTE
+// and BYSECOND by its time of day. Nth weekdays only move by whole weeks, so
+// that the 2nd Tuesday of the month moved by a week is the 3rd Tuesday. WKST
+// moves with the days when it delimits the periods of a weekly rule.
+//
+// It returns an error wrapping ErrNotShiftable when a part cannot follow without
+// adding or dropping occurrences, e.g. the 2nd Tuesday moved by a day, which is
+// not always the 2nd Wednesday, a BYMONTHDAY=30 moved back two days, which would
+// also match in February, times of day that would no longer be a combination of
+// hours, minutes and seconds or would not all move to the same day, a BYWEEKNO
+// or the BYSETPOS of a monthly or yearly rule moved by days, or a rule that
+// started on an occurrence no longer doing so.
+func (r *RRule) Shift(d time.Duration) (*RRule, error) {
+	if d%time.Second != 0 {
+		return nil, fmt.Errorf("%w: %v is not a whole number of seconds", ErrNotShiftable, d)
+	}
+
+	option, err := r.shiftedOptions(d)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateBounds(option); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrNotShiftable, err)
+	}
+
+	rule := buildRRule(option)
+	rule.i18n = r.i18n
+
+	// The rewritten parts must still describe the moved DTSTART.
+	if first, ok := r.Iterator()(); ok && first.Equal(r.dtstart) {
+		if first, ok := rule.Iterator()(); !ok || !first.Equal(rule.dtstart) {
+			return nil, fmt.Errorf("%w: %v is not an occurrence of the shifted rule", ErrNotShiftable, rule.dtstart)
+		}
+	}
+
+	return &rule, nil
+}
+
+// Shift returns a copy of the set moved by d, with its RRULE shifted as by
+// RRule.Shift and its RDATE and EXDATE values moved by d on the wall clock.
+func (set *Set) Shift(d time.Duration) (*Set, error) {
+	if d%time.Second != 0 {
+		return nil, fmt.Errorf("%w: %v is not a whole number of seconds", ErrNotShiftable, d)
+	}
+
+	shifted := &Set{}
+	if !set.dtstart.IsZero() {
+		shifted.dtstart = shiftWallClock(set.dtstart, d)
+	}
+
+	if set.rrule != nil {
+		rule, err := set.rrule.Shift(d)
+		if err != nil {
+			return nil, err
+		}
+		shifted.RRule(rule)
+	}
+
+	for _, rdate := range set.rdate {
+		shifted.rdate = append(shifted.rdate, shiftWallClock(rdate, d))
+	}
+	for _, exdate := range set.exdate {
+		shifted.exdate = append(shifted.exdate, shiftWallClock(exdate, d))
+	}
+
+	return shifted, nil
+}
+
+// shiftedOptions returns the options of the rule moved by d.
+func (r *RRule) shiftedOptions(d time.Duration) (ROption, error) {
+	option := r.OrigOptions
+	option.Dtstart = shiftWallClock(r.dtstart, d)
+	if !option.Until.IsZero() {
+		option.Until = shiftWallClock(option.Until.In(r.dtstart.Location()), d).In(option.Until.Location())
+	}
+
+	days, clock := divmod(int(d/time.Second), 86400)
+	hours, minutes, seconds, carry, err := r.shiftedTimes(clock)
+	if err != nil {
+		return option, err
+	}
+	if carry >= 0 {
+		days += carry
+	} else if r.freq <= DAILY || r.hasDayParts() {
+		return option, fmt.Errorf("%w: times of day move to different days", ErrNotShiftable)
+	}
+
+	if days != 0 {
+		if len(option.Byweekno) != 0 {
+			return option, fmt.Errorf("%w: BYWEEKNO cannot move by days", ErrNotShiftable)
+		}
+
+		if option.Byweekday, err = r.shiftedWeekdays(days); err != nil {
+			return option, err
+		}
+		// Days of the month past the 28th are not in every month, and the 366th
+		// day not in every year, so they cannot move without adding or dropping
+		// occurrences.
+		if option.Bymonthday, err = shiftDays(r.OrigOptions.Bymonthday, days, 28, "BYMONTHDAY"); err != nil {
+			return option, err
+		}
+		if option.Byyearday, err = shiftDays(r.OrigOptions.Byyearday, days, 365, "BYYEARDAY"); err != nil {
+			return option, err
+		}
+		if len(r.OrigOptions.Bymonth) != 0 && len(r.OrigOptions.Bymonthday) == 0 &&
+			(everyWeekday(r.OrigOptions.Byweekday) || len(r.OrigOptions.Byyearday) != 0 || len(r.OrigOptions.Byeaster) != 0) {
+			return option, fmt.Errorf("%w: days of BYMONTH cannot move by days", ErrNotShiftable)
+		}
+		if len(r.bysetpos) != 0 && (r.freq == MONTHLY || r.freq == YEARLY) {
+			return option, fmt.Errorf("%w: BYSETPOS of a %v rule cannot move by days", ErrNotShiftable, r.freq)
+		}
+		option.Byeaster = nil
+		for _, offset := range r.OrigOptions.Byeaster {
+			option.Byeaster = append(option.Byeaster, offset+days)
+		}
+		if r.freq == WEEKLY && (r.interval > 1 || len(r.bysetpos) != 0) {
+			option.Wkst = Weekday{weekday: pymod(r.wkst+days, 7)}
+		}
+	}
+
+	// Times of day implied by DTSTART are only given when the moved DTSTART
+	// implies other ones.
+	option.Byhour, option.Byminute, option.Bysecond = nil, nil, nil
+	if len(r.OrigOptions.Byhour) != 0 {
+		option.Byhour = hours
+	}
+	if len(r.OrigOptions.Byminute) != 0 {
+		option.Byminute = minutes
+	}
+	if len(r.OrigOptions.Bysecond) != 0 {
+		option.Bysecond = seconds
+	}
+	rule := buildRRule(option)
+	if !equalInts(uniqueInts(rule.byhour), hours) || !equalInts(uniqueInts(rule.byminute), minutes) ||
+		!equalInts(uniqueInts(rule.bysecond), seconds) {
+		option.Byhour, option.Byminute, option.Bysecond = hours, minutes, seconds
+	}
+
+	return option, nil
+}
+
+// shiftedTimes returns the hours, minutes and seconds of the times of day of the
+// rule moved by clock seconds, and the days they all move by, or -1 if they move
+// by different days. An empty list stands for any value, as in the rule.
+func (r *RRule) shiftedTimes(clock int) (hours, minutes, seconds []int, carry int, err error) {
+	all := func(list []int, n int) []int {
+		if len(list) != 0 {
+			return list
+		}
+		list = make([]int, n)
+		for i := range list {
+			list[i] = i
+		}
+		return list
+	}
+
+	carry = -2
+	moved := make(map[int]bool)
+	for _, hour := range all(r.byhour, 24) {
+		for _, minute := range all(r.byminute, 60) {
+			for _, second := range all(r.bysecond, 60) {
+				days, tod := divmod(hour*3600+minute*60+second+clock, 86400)
+				if carry == -2 {
+					carry = days
+				} else if carry != days {
+					carry = -1
+				}
+
+				moved[tod] = true
+				hours = append(hours, tod/3600)
+				minutes = append(minutes, tod/60%60)
+				seconds = append(seconds, tod%60)
+			}
+		}
+	}
+
+	hours, minutes, seconds = uniqueInts(hours), uniqueInts(minutes), uniqueInts(seconds)
+	if len(moved) != len(hours)*len(minutes)*len(seconds) {
+		return nil, nil, nil, 0, fmt.Errorf("%w: moved times of day are not combinations of BYHOUR, BYMINUTE and BYSECOND", ErrNotShiftable)
+	}
+
+	if len(r.byhour) == 0 && len(hours) == 24 {
+		hours = nil
+	}
+	if len(r.byminute) == 0 && len(minutes) == 60 {
+		minutes = nil
+	}
+	if len(r.bysecond) == 0 && len(seconds) == 60 {
+		seconds = nil
+	}
+
+	return hours, minutes, seconds, carry, nil
+}
+
+// shiftedWeekdays returns the weekdays of BYDAY moved by days. An nth weekday
+// only moves by whole weeks, to another nth weekday found in every month, or in
+// every year for a yearly rule without BYMONTH.
+func (r *RRule) shiftedWeekdays(days int) ([]Weekday, error) {
+	limit := 52
+	if r.freq == MONTHLY || len(r.bymonth) != 0 {
+		limit = 4
+	}
+
+	var shifted []Weekday
+	for _, wday := range r.OrigOptions.Byweekday {
+		if wday.n == 0 {
+			shifted = append(shifted, Weekday{weekday: pymod(wday.weekday+days, 7)})
+			continue
+		}
+
+		n := wday.n + days/7
+		if days%7 != 0 || wday.n > limit || wday.n < -limit ||
+			wday.n > 0 && (n < 1 || n > limit) || wday.n < 0 && (n > -1 || n < -limit) {
+			return nil, fmt.Errorf("%w: BYDAY=%v cannot move by %d days", ErrNotShiftable, wday, days)
+		}
+		shifted = append(shifted, Weekday{weekday: wday.weekday, n: n})
+	}
+
+	return shifted, nil
+}
+
+// everyWeekday reports whether weekdays has a weekday without n, matching every
+// such day of the period.
+func everyWeekday(weekdays []Weekday) bool {
+	for _, wday := range weekdays {
+		if wday.n == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// hasDayParts reports whether the rule selects days with BYxxx parts.
+func (r *RRule) hasDayParts() bool {
+	return len(r.bymonth) != 0 || len(r.bymonthday) != 0 || len(r.bynmonthday) != 0 ||
+		len(r.byyearday) != 0 || len(r.byweekno) != 0 || len(r.byweekday) != 0 ||
+		len(r.bynweekday) != 0 || len(r.byeaster) != 0 || len(r.bysetpos) != 0
+}
+
+// shiftDays moves the days of the month or year in list by days, which must keep
+// them counted from the same end and within the first or last max days of every
+// month or year.
+func shiftDays(list []int, days, max int, param string) ([]int, error) {
+	var shifted []int
+	for _, day := range list {
+		moved := day + days
+		if day > max || day < -max || day > 0 && (moved < 1 || moved > max) || day < 0 && (moved > -1 || moved < -max) {
+			return nil, fmt.Errorf("%w: %s=%d cannot move by %d days", ErrNotShiftable, param, day, days)
+		}
+		shifted = append(shifted, moved)
+	}
+
+	return shifted, nil
+}
+
+// shiftWallClock moves t by d on its wall clock: whole days of d move it by
+// calendar days, and the rest by the time of day.
+func shiftWallClock(t time.Time, d time.Duration) time.Time {
+	days, clock := divmod(int(d/time.Second), 86400)
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, month, day+days, hour, minute, second+clock, t.Nanosecond(), t.Location())
+}
@@ -0,0 +1,162 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShift(t *testing.T) {
+	var tests = []struct {
+		rule     string
+		d        time.Duration
+		expected string
+	}{
+		{
+			"DTSTART:20240102T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;COUNT=10;BYDAY=TU",
+			24 * time.Hour,
+			"DTSTART:20240103T090000Z\nRRULE:FREQ=WEEKLY;INTERVAL=2;WKST=TU;COUNT=10;BYDAY=WE",
+		},
+		{
+			"DTSTART:20240102T090000Z\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=TU,SU",
+			-48 * time.Hour,
+			"DTSTART:20231231T090000Z\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=SU,FR",
+		},
+		{
+			"DTSTART:20240115T090000Z\nRRULE:FREQ=MONTHLY;COUNT=10;BYMONTHDAY=15,25",
+			-48 * time.Hour,
+			"DTSTART:20240113T090000Z\nRRULE:FREQ=MONTHLY;COUNT=10;BYMONTHDAY=13,23",
+		},
+		{
+			"DTSTART:20240102T090000Z\nRRULE:FREQ=DAILY;COUNT=10;BYHOUR=9,17",
+			90 * time.Minute,
+			"DTSTART:20240102T103000Z\nRRULE:FREQ=DAILY;COUNT=10;BYHOUR=10,18",
+		},
+		{
+			"DTSTART:20240102T220000Z\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=TU,TH;BYHOUR=22,23",
+			3 * time.Hour,
+			"DTSTART:20240103T010000Z\nRRULE:FREQ=WEEKLY;COUNT=10;BYDAY=WE,FR;BYHOUR=1,2",
+		},
+		{
+			"DTSTART:20240101T000000Z\nRRULE:FREQ=HOURLY;INTERVAL=6;COUNT=10",
+			2 * time.Hour,
+			"DTSTART:20240101T020000Z\nRRULE:FREQ=HOURLY;INTERVAL=6;COUNT=10",
+		},
+		{
+			"DTSTART:20240101T090000Z\nRRULE:FREQ=YEARLY;COUNT=5;BYEASTER=0",
+			-48 * time.Hour,
+			"DTSTART:20231230T090000Z\nRRULE:FREQ=YEARLY;COUNT=5;BYEASTER=-2",
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		got, err := r.Shift(tt.d)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.rule, err)
+		}
+		if got.String() != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.rule, tt.expected, got.String())
+		}
+
+		var want []time.Time
+		for _, dt := range r.All() {
+			want = append(want, shiftWallClock(dt, tt.d))
+		}
+		if !timesEqual(got.All(), want) {
+			t.Errorf("%q: expected %v, got %v", tt.rule, want, got.All())
+		}
+	}
+}
+
+func TestShiftNthWeekday(t *testing.T) {
+	r, _ := StrToRRule("DTSTART:20240109T090000Z\nRRULE:FREQ=MONTHLY;COUNT=3;BYDAY=2TU")
+
+	got, err := r.Shift(7 * 24 * time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "DTSTART:20240116T090000Z\nRRULE:FREQ=MONTHLY;COUNT=3;BYDAY=+3TU"
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+
+	want := []time.Time{
+		time.Date(2024, 1, 16, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 20, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 19, 9, 0, 0, 0, time.UTC),
+	}
+	if !timesEqual(got.All(), want) {
+		t.Errorf("expected %v, got %v", want, got.All())
+	}
+}
+
+func TestShiftNotShiftable(t *testing.T) {
+	var tests = []struct {
+		rule string
+		d    time.Duration
+	}{
+		{"DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=31", 24 * time.Hour},
+		{"DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=-1", 24 * time.Hour},
+		{"DTSTART:20240102T090000Z\nRRULE:FREQ=DAILY;BYHOUR=9,23", 2 * time.Hour},
+		{"DTSTART:20240102T090000Z\nRRULE:FREQ=DAILY;BYHOUR=9,10;BYMINUTE=0,30", 30 * time.Minute},
+		{"DTSTART:20240101T090000Z\nRRULE:FREQ=YEARLY;BYWEEKNO=1", 24 * time.Hour},
+		{"DTSTART:20240123T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=4TU", 6 * 24 * time.Hour},
+		// The 2nd Wednesday is not the day after the 2nd Tuesday in May 2024.
+		{"DTSTART:20240109T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=2TU", 24 * time.Hour},
+		{"DTSTART:20240123T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=4TU", 7 * 24 * time.Hour},
+		// The 28th would also match in February.
+		{"DTSTART:20240130T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=30", -48 * time.Hour},
+		{"DTSTART:20240128T090000Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=28", 24 * time.Hour},
+		{"DTSTART:20240101T090000Z\nRRULE:FREQ=YEARLY;BYMONTH=1;BYDAY=MO", 24 * time.Hour},
+		{"DTSTART:20240131T090000Z\nRRULE:FREQ=MONTHLY;BYDAY=MO,TU,WE,TH,FR;BYSETPOS=-1", 24 * time.Hour},
+		{"DTSTART:20240102T090000Z\nRRULE:FREQ=DAILY", time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		if _, err := r.Shift(tt.d); !errors.Is(err, ErrNotShiftable) {
+			t.Errorf("%q by %v: expected ErrNotShiftable, got %v", tt.rule, tt.d, err)
+		}
+	}
+}
+
+func TestSetShift(t *testing.T) {
+	set, err := StrToRRuleSet("DTSTART;TZID=America/New_York:20240305T090000\n" +
+		"RRULE:FREQ=WEEKLY;UNTIL=20240326T130000Z;BYDAY=TU\n" +
+		"RDATE;TZID=America/New_York:20240307T090000\n" +
+		"EXDATE;TZID=America/New_York:20240312T090000")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	got, err := set.Shift(24 * time.Hour)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := "DTSTART;TZID=America/New_York:20240306T090000\n" +
+		"RRULE:FREQ=WEEKLY;UNTIL=20240327T130000Z;BYDAY=WE\n" +
+		"RDATE;TZID=America/New_York:20240308T090000\n" +
+		"EXDATE;TZID=America/New_York:20240313T090000"
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+
+	var want []time.Time
+	for _, dt := range set.All() {
+		want = append(want, shiftWallClock(dt, 24*time.Hour))
+	}
+	if !timesEqual(got.All(), want) {
+		t.Errorf("expected %v, got %v", want, got.All())
+	}
+}
@@ -0,0 +1,262 @@
+package rrule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrEmptySplit is returned by SplitAt when one side of the split would have
+// no occurrence.
+var ErrEmptySplit = errors.New("rrule: split leaves a side without occurrence")
+
+// ErrNotSplittable is returned by SplitAt when no rule generates exactly the
+// occurrences at and after the split, e.g. because BYSETPOS picks them in a
+// period that would start before the new DTSTART.
+var ErrNotSplittable = errors.New("rrule: rule cannot be split")
+
+// SplitAt splits the rule at its first occurrence at or after t, typically to
+// edit "this and all following" occurrences. before generates the occurrences
+// before that one and after the others, starting at that one.
+//
+// before ends with the COUNT of its occurrences if the rule has a COUNT, and with
+// an UNTIL at its last occurrence otherwise. after keeps the remaining COUNT, or
+// the UNTIL of the rule. The parts of the rule implied by DTSTART, such as its
+// time of day, are made explicit in after when its new DTSTART would imply other
+// ones, e.g. because a daylight saving time transition moved it.
+//
+// A rule with BYSETPOS selects its occurrences in whole periods, so after starts
+// at the beginning of the period of that occurrence, e.g. the week of WKST, when
+// its earlier occurrences in that period would otherwise change the selection.
+//
+// It returns an error wrapping ErrEmptySplit if no occurrence is before t, or none
+// at or after t, and one wrapping ErrNotSplittable if after cannot generate
+// exactly the remaining occurrences.
+func (r *RRule) SplitAt(t time.Time) (before, after *RRule, err error) {
+	before, after, err = r.splitAt(t)
+	if err != nil {
+		return nil, nil, err
+	}
+	if before == nil {
+		return nil, nil, fmt.Errorf("%w: no occurrence before %v", ErrEmptySplit, t)
+	}
+	if after == nil {
+		return nil, nil, fmt.Errorf("%w: no occurrence at or after %v", ErrEmptySplit, t)
+	}
+
+	return before, after, nil
+}
+
+// SplitAt splits the set at its first occurrence at or after t, typically to edit
+// "this and all following" occurrences. before generates the occurrences before
+// that one and after the others, starting at that one.
+//
+// The RRULE is split as by RRule.SplitAt, and RDATE and EXDATE values go to the
+// side they fall in. A side without occurrence of the RRULE has no RRULE.
+//
+// It returns an error wrapping ErrEmptySplit if no occurrence is before t, or none
+// at or after t, and one wrapping ErrNotSplittable if the RRULE cannot be split.
+func (set *Set) SplitAt(t time.Time) (before, after *Set, err error) {
+	at := set.After(t, true)
+	if at.IsZero() {
+		return nil, nil, fmt.Errorf("%w: no occurrence at or after %v", ErrEmptySplit, t)
+	}
+	if set.Before(at, false).IsZero() {
+		return nil, nil, fmt.Errorf("%w: no occurrence before %v", ErrEmptySplit, t)
+	}
+
+	before = &Set{dtstart: set.dtstart}
+	after = &Set{dtstart: at}
+
+	if set.rrule != nil {
+		ruleBefore, ruleAfter, err := set.rrule.splitAt(at)
+		if err != nil {
+			return nil, nil, err
+		}
+		if ruleBefore != nil {
+			before.RRule(ruleBefore)
+		}
+		if ruleAfter != nil {
+			after.RRule(ruleAfter)
+		}
+	}
+
+	for _, rdate := range set.rdate {
+		if rdate.Before(at) {
+			before.rdate = append(before.rdate, rdate)
+		} else {
+			after.rdate = append(after.rdate, rdate)
+		}
+	}
+
+	for _, exdate := range set.exdate {
+		if exdate.Before(at) {
+			before.exdate = append(before.exdate, exdate)
+		} else {
+			after.exdate = append(after.exdate, exdate)
+		}
+	}
+
+	return before, after, nil
+}
+
+// splitAt splits the occurrences of the rule at t, returning nil for a side
+// without occurrence.
+func (r *RRule) splitAt(t time.Time) (before, after *RRule, err error) {
+	var last, first time.Time
+	count := 0
+
+	next := r.Iterator()
+	for dt, ok := next(); ok; dt, ok = next() {
+		if !dt.Before(t) {
+			first = dt
+			break
+		}
+
+		last = dt
+		count++
+	}
+
+	if count > 0 {
+		// DTSTART is kept, rather than defaulted to now again.
+		option := r.OrigOptions
+		option.Dtstart = r.dtstart
+		if r.count > 0 {
+			option.Count = count
+		} else {
+			option.Until = last
+		}
+
+		rule := buildRRule(option)
+		rule.i18n = r.i18n
+		before = &rule
+	}
+
+	if !first.IsZero() {
+		option := r.OrigOptions
+		if r.count > 0 {
+			option.Count = r.count - count
+		}
+
+		// The first period of a rule starts at DTSTART, which changes what
+		// BYSETPOS selects in it unless DTSTART is at the start of the period.
+		after = r.ruleFrom(option, first)
+		if len(r.bysetpos) > 0 && !r.startsLike(after, first) {
+			after = r.ruleFrom(option, r.periodStart(first))
+			if !r.startsLike(after, first) {
+				return nil, nil, fmt.Errorf("%w: BYSETPOS selects other occurrences from %v", ErrNotSplittable, first)
+			}
+		}
+	}
+
+	return before, after, nil
+}
+
+// ruleFrom builds the rule of option starting at dtstart, with the parts implied
+// by the DTSTART of r made explicit when dtstart would imply other ones.
+func (r *RRule) ruleFrom(option ROption, dtstart time.Time) *RRule {
+	option.Dtstart = dtstart
+	rule := buildRRule(option)
+	if !r.sameImpliedParts(&rule) {
+		option.Bymonth = r.Options.Bymonth
+		option.Bymonthday = r.Options.Bymonthday
+		option.Byweekday = r.Options.Byweekday
+		option.Byhour = r.byhour
+		option.Byminute = r.byminute
+		option.Bysecond = r.bysecond
+		rule = buildRRule(option)
+	}
+
+	rule.i18n = r.i18n
+	return &rule
+}
+
+// startsLike reports whether rule generates the occurrences of r from first to
+// the end of the period of first, and none before. Later periods are whole in
+// both rules, so they select the same occurrences.
+func (r *RRule) startsLike(rule *RRule, first time.Time) bool {
+	end := r.periodEnd(first)
+	var want, got []time.Time
+	next := r.iteratorFrom(first)
+	for dt, ok := next(); ok && dt.Before(end); dt, ok = next() {
+		if !dt.Before(first) {
+			want = append(want, dt)
+		}
+	}
+	next = rule.Iterator()
+	for dt, ok := next(); ok && dt.Before(end); dt, ok = next() {
+		got = append(got, dt)
+	}
+
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if !got[i].Equal(want[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// periodStart returns the start of the period of the frequency of r containing
+// t, where a week starts on WKST.
+func (r *RRule) periodStart(t time.Time) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	switch r.freq {
+	case YEARLY:
+		month, day = time.January, 1
+	case MONTHLY:
+		day = 1
+	case WEEKLY:
+		day -= (toPyWeekday(t.Weekday()) - r.wkst + 7) % 7
+	}
+
+	switch r.freq {
+	case YEARLY, MONTHLY, WEEKLY, DAILY:
+		hour = 0
+		fallthrough
+	case HOURLY:
+		minute = 0
+		fallthrough
+	case MINUTELY:
+		second = 0
+	}
+
+	return time.Date(year, month, day, hour, minute, second, 0, t.Location())
+}
+
+// periodEnd returns the start of the period of the frequency of r following the
+// one containing t.
+func (r *RRule) periodEnd(t time.Time) time.Time {
+	start := r.periodStart(t)
+	switch r.freq {
+	case YEARLY:
+		return start.AddDate(1, 0, 0)
+	case MONTHLY:
+		return start.AddDate(0, 1, 0)
+	case WEEKLY:
+		return start.AddDate(0, 0, 7)
+	case DAILY:
+		return start.AddDate(0, 0, 1)
+	case HOURLY:
+		return start.Add(time.Hour)
+	case MINUTELY:
+		return start.Add(time.Minute)
+	}
+
+	return start.Add(time.Second)
+}
+
+// sameImpliedParts reports whether other has the same parts implied by DTSTART,
+// when not given, as r.
+func (r *RRule) sameImpliedParts(other *RRule) bool {
+	return equalInts(r.Options.Bymonth, other.Options.Bymonth) &&
+		equalInts(r.Options.Bymonthday, other.Options.Bymonthday) &&
+		equalWeekdays(r.Options.Byweekday, other.Options.Byweekday) &&
+		equalInts(r.byhour, other.byhour) &&
+		equalInts(r.byminute, other.byminute) &&
+		equalInts(r.bysecond, other.bysecond)
+}
@@ -0,0 +1,156 @@
+package rrule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestSplitAt(t *testing.T) {
+	newYork, _ := time.LoadLocation("America/New_York")
+
+	var tests = []struct {
+		rule   string
+		at     time.Time
+		before string
+		after  string
+	}{
+		{
+			rule:   "DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=10",
+			at:     time.Date(2024, 1, 4, 9, 0, 0, 0, time.UTC),
+			before: "DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=3",
+			after:  "DTSTART:20240104T090000Z\nRRULE:FREQ=DAILY;COUNT=7",
+		},
+		{
+			rule:   "DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE",
+			at:     time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			before: "DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;UNTIL=20240108T090000Z;BYDAY=MO,WE",
+			after:  "DTSTART:20240110T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE",
+		},
+		{
+			rule:   "DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;COUNT=6;BYSETPOS=-1;BYDAY=MO,TU,WE,TH,FR",
+			at:     time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+			before: "DTSTART:20240101T090000Z\nRRULE:FREQ=MONTHLY;COUNT=2;BYSETPOS=-1;BYDAY=MO,TU,WE,TH,FR",
+			after:  "DTSTART:20240329T090000Z\nRRULE:FREQ=MONTHLY;COUNT=4;BYSETPOS=-1;BYDAY=MO,TU,WE,TH,FR",
+		},
+		{
+			rule:   "DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;COUNT=6;BYSETPOS=2;BYDAY=MO,WE,FR",
+			at:     time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			before: "DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;COUNT=1;BYSETPOS=2;BYDAY=MO,WE,FR",
+			after:  "DTSTART:20240108T000000Z\nRRULE:FREQ=WEEKLY;COUNT=5;BYSETPOS=2;BYDAY=MO,WE,FR;BYHOUR=9;BYMINUTE=0;BYSECOND=0",
+		},
+		{
+			rule:   "DTSTART;TZID=America/New_York:20240308T023000\nRRULE:FREQ=DAILY;COUNT=5",
+			at:     time.Date(2024, 3, 10, 0, 0, 0, 0, newYork),
+			before: "DTSTART;TZID=America/New_York:20240308T023000\nRRULE:FREQ=DAILY;COUNT=2",
+			after:  "DTSTART;TZID=America/New_York:20240310T013000\nRRULE:FREQ=DAILY;COUNT=3;BYHOUR=2;BYMINUTE=30;BYSECOND=0",
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		before, after, err := r.SplitAt(tt.at)
+		if err != nil {
+			t.Fatalf("%q: SplitAt error: %v", tt.rule, err)
+		}
+		if got := before.OrigOptions.String(); got != tt.before {
+			t.Errorf("%q: expected before %q, got %q", tt.rule, tt.before, got)
+		}
+		if got := after.OrigOptions.String(); got != tt.after {
+			t.Errorf("%q: expected after %q, got %q", tt.rule, tt.after, got)
+		}
+
+		horizon := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		want := r.Between(time.Time{}, horizon, true)
+		got := append(before.Between(time.Time{}, horizon, true), after.Between(time.Time{}, horizon, true)...)
+		if !timesEqual(got, want) {
+			t.Errorf("%q: expected %v, got %v", tt.rule, want, got)
+		}
+	}
+}
+
+func TestSplitAtEmptySide(t *testing.T) {
+	r, _ := StrToRRule("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=3")
+
+	for _, at := range []time.Time{
+		time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 1, 3, 9, 0, 1, 0, time.UTC),
+	} {
+		if _, _, err := r.SplitAt(at); !errors.Is(err, ErrEmptySplit) {
+			t.Errorf("%v: expected ErrEmptySplit, got %v", at, err)
+		}
+	}
+}
+
+func TestSetSplitAt(t *testing.T) {
+	set, err := StrToRRuleSet("DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=6\n" +
+		"RDATE:20231225T090000Z,20240110T090000Z\nEXDATE:20240102T090000Z,20240105T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	before, after, err := set.SplitAt(time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("SplitAt error: %v", err)
+	}
+
+	expected := "DTSTART:20240101T090000Z\nRRULE:FREQ=DAILY;COUNT=3\nRDATE:20231225T090000Z\nEXDATE:20240102T090000Z"
+	if got := before.String(); got != expected {
+		t.Errorf("expected before %q, got %q", expected, got)
+	}
+
+	expected = "DTSTART:20240104T090000Z\nRRULE:FREQ=DAILY;COUNT=3\nRDATE:20240110T090000Z\nEXDATE:20240105T090000Z"
+	if got := after.String(); got != expected {
+		t.Errorf("expected after %q, got %q", expected, got)
+	}
+
+	if got, want := append(before.All(), after.All()...), set.All(); !timesEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if _, _, err := set.SplitAt(time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrEmptySplit) {
+		t.Errorf("expected ErrEmptySplit, got %v", err)
+	}
+}
+
+func TestSplitAtKeepsDTStart(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: SECONDLY, Count: 4})
+	time.Sleep(time.Second)
+
+	before, _, err := r.SplitAt(r.GetDTStart().Add(2 * time.Second))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !before.GetDTStart().Equal(r.GetDTStart()) {
+		t.Errorf("expected DTSTART %v, got %v", r.GetDTStart(), before.GetDTStart())
+	}
+	if !timesEqual(before.All(), r.All()[:2]) {
+		t.Errorf("expected %v, got %v", r.All()[:2], before.All())
+	}
+}
+
+func TestSplitAtBySetPos(t *testing.T) {
+	r, _ := StrToRRule("DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;COUNT=6;BYSETPOS=2;BYDAY=MO,WE,FR")
+
+	before, after, err := r.SplitAt(time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("SplitAt error: %v", err)
+	}
+	if got := after.All()[0]; !got.Equal(time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected after to start on 2024-01-10, got %v", got)
+	}
+	if got, want := append(before.All(), after.All()...), r.All(); !timesEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	// From the Wednesday, BYSETPOS=1,2 also picks the Friday, and from the Monday
+	// it picks the Monday, which is before the split.
+	r, _ = StrToRRule("DTSTART:20240101T090000Z\nRRULE:FREQ=WEEKLY;COUNT=6;BYSETPOS=1,2;BYDAY=MO,WE,FR")
+	if _, _, err := r.SplitAt(time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC)); !errors.Is(err, ErrNotSplittable) {
+		t.Errorf("expected ErrNotSplittable, got %v", err)
+	}
+}
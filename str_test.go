@@ -499,8 +499,8 @@ func TestToText(t *testing.T) {
 		rule     string
 		expected string
 	}{
-		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11,12;BYMINUTE=30;BYSECOND=0", expected: "every 2 days at 11 and 12 until December 14, 2017"},
-		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11;BYMINUTE=30;BYSECOND=0", expected: "every 2 days at 11 until December 14, 2017"},
+		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11,12;BYMINUTE=30;BYSECOND=0", expected: "every 2 days at 11:30 AM and 12:30 PM until December 14, 2017"},
+		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11;BYMINUTE=30;BYSECOND=0", expected: "every 2 days at 11:30 AM until December 14, 2017"},
 	}
 
 	for _, tt := range tests {
@@ -584,11 +584,11 @@ func TestToTextWithCustomFormatter(t *testing.T) {
 		rule     string
 		expected string
 	}{
-		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11,12;BYMINUTE=30;BYSECOND=0", expected: "setiap 2 hari pada pukul 11 dan 12 sampai 14 Desember 2017"},
-		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11;BYMINUTE=30;BYSECOND=0", expected: "setiap 2 hari pada pukul 11 sampai 14 Desember 2017"},
+		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11,12;BYMINUTE=30;BYSECOND=0", expected: "setiap 2 hari pada pukul 11:30 dan 12:30 sampai 14 Desember 2017"},
+		{rule: "DTSTART:20171101T010000Z\nRRULE:UNTIL=20171214T013000Z;FREQ=DAILY;INTERVAL=2;WKST=MO;BYHOUR=11;BYMINUTE=30;BYSECOND=0", expected: "setiap 2 hari pada pukul 11:30 sampai 14 Desember 2017"},
 		{expected: "setiap hari", rule: "RRULE:FREQ=DAILY"},
-		{expected: "setiap hari pada pukul 10, 12 dan 17", rule: "RRULE:FREQ=DAILY;BYHOUR=10,12,17"},
-		{expected: "setiap minggu pada hari Minggu pada pukul 10, 12 dan 17", rule: "RRULE:FREQ=WEEKLY;BYDAY=SU;BYHOUR=10,12,17"},
+		{expected: "setiap hari pada pukul 10:00, 12:00 dan 17:00", rule: "RRULE:FREQ=DAILY;BYHOUR=10,12,17"},
+		{expected: "setiap minggu pada hari Minggu pada pukul 10:00, 12:00 dan 17:00", rule: "RRULE:FREQ=WEEKLY;BYDAY=SU;BYHOUR=10,12,17"},
 		{expected: "setiap minggu", rule: "RRULE:FREQ=WEEKLY"},
 		{expected: "setiap jam", rule: "RRULE:FREQ=HOURLY"},
 		{expected: "setiap 4 jam", rule: "RRULE:INTERVAL=4;FREQ=HOURLY"},
@@ -0,0 +1,488 @@
+// Package systemd converts systemd calendar event expressions, as given to
+// OnCalendar= in timer units, to recurrence rules and back.
+//
+// An expression is "[weekdays] [year-month-day] [hour:minute[:second]] [zone]",
+// e.g. "Mon..Fri *-*-* 09:00:00" or "*-*-01,15 00:00 Europe/Berlin". Values may
+// be "*", lists, ranges "a..b" and repetitions "a/n", and "~" before the day
+// counts the days from the end of the month. An omitted date is "*-*-*", an
+// omitted time is 00:00:00, and the shorthands minutely, hourly, daily, weekly,
+// monthly, quarterly, semiannually, yearly and annually are accepted. Unlike in
+// cron, a time must match both the weekdays and the date, as in a rule.
+package systemd
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/xyedo/rrule"
+	"github.com/xyedo/rrule/internal/field"
+)
+
+// UnrepresentableError is returned for the constructs of an expression no rule
+// expresses, by ToROption, and for the parts of a rule no expression expresses,
+// by FromROption.
+type UnrepresentableError struct {
+	// Parts are the constructs, e.g. "year 2024,2026", or the names of the
+	// parts, e.g. "COUNT" or "BYSETPOS".
+	Parts []string
+}
+
+func (e *UnrepresentableError) Error() string {
+	return "systemd: cannot express " + strings.Join(e.Parts, ", ")
+}
+
+var shorthands = map[string]string{
+	"minutely":     "*-*-* *:*:00",
+	"hourly":       "*-*-* *:00:00",
+	"daily":        "*-*-* 00:00:00",
+	"weekly":       "Mon *-*-* 00:00:00",
+	"monthly":      "*-*-01 00:00:00",
+	"quarterly":    "*-01,04,07,10-01 00:00:00",
+	"semiannually": "*-01,07-01 00:00:00",
+	"yearly":       "*-01-01 00:00:00",
+	"annually":     "*-01-01 00:00:00",
+}
+
+// weekdayNames are the names of the weekdays, from Monday as in rules.
+var weekdayNames = [...]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}
+
+var fullWeekdayNames = [...]string{"Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday", "Sunday"}
+
+var weekdays = [...]rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR, rrule.SA, rrule.SU}
+
+// syntax is how calendar event expressions write the values of their fields,
+// padded to 2 digits, with repetitions "a/n" from the first value.
+var syntax = field.Syntax{
+	Name:  "systemd",
+	Range: "..",
+	Step:  "repetition",
+	Width: 2,
+	Repeat: func(first, step, min int) string {
+		return fmt.Sprintf("%02d/%d", first, step)
+	},
+}
+
+// ToROption converts the calendar event expression expr to the options of a
+// rule starting at dtstart, which generates the times expr matches from dtstart
+// on. With a time zone, DTSTART is moved to it.
+//
+// Years are expressed by moving DTSTART to the start of the first year and with
+// an UNTIL at the end of the last one, so only a year or a range of years is
+// supported. An *UnrepresentableError lists the other unsupported constructs.
+func ToROption(expr string, dtstart time.Time) (rrule.ROption, error) {
+	option := rrule.ROption{Dtstart: dtstart}
+
+	tokens := strings.Fields(expr)
+	if len(tokens) == 0 {
+		return option, fmt.Errorf("systemd: empty expression")
+	}
+	if shorthand, ok := shorthands[strings.ToLower(tokens[0])]; ok {
+		tokens = append(strings.Fields(shorthand), tokens[1:]...)
+	}
+
+	if last := tokens[len(tokens)-1]; isLetter(last[0]) {
+		if loc, err := time.LoadLocation(last); err == nil {
+			option.Dtstart = dtstart.In(loc)
+			tokens = tokens[:len(tokens)-1]
+		}
+	}
+
+	weekday := field.Field{All: true}
+	if len(tokens) != 0 && isLetter(tokens[0][0]) {
+		var err error
+		if weekday, err = parseWeekdays(tokens[0]); err != nil {
+			return option, err
+		}
+		tokens = tokens[1:]
+	}
+
+	date, clock := "", ""
+	for _, token := range tokens {
+		switch {
+		case strings.Contains(token, ":") && clock == "":
+			clock = token
+		case !strings.Contains(token, ":") && date == "" && clock == "":
+			date = token
+		default:
+			return option, fmt.Errorf("systemd: unexpected %q in %q", token, expr)
+		}
+	}
+	if date == "" {
+		date = "*-*-*"
+	}
+	if clock == "" {
+		clock = "00:00:00"
+	}
+
+	var unrepresentable []string
+
+	year, month, day, last, err := parseDate(date)
+	if err != nil {
+		return option, err
+	}
+	if !year.All {
+		if field.Step(year.Values) != 1 && len(year.Values) > 1 {
+			years := syntax
+			years.Width = 4
+			unrepresentable = append(unrepresentable, "year "+years.Format(year.Values, 0, 0))
+		} else {
+			loc := option.Dtstart.Location()
+			first := time.Date(year.Values[0], 1, 1, 0, 0, 0, 0, loc)
+			if option.Dtstart.Before(first) {
+				option.Dtstart = first
+			}
+			option.Until = time.Date(year.Values[len(year.Values)-1]+1, 1, 1, 0, 0, 0, 0, loc).Add(-time.Second)
+		}
+	}
+
+	hour, minute, second, err := parseTime(clock)
+	if err != nil {
+		if e, ok := err.(*UnrepresentableError); ok {
+			unrepresentable = append(unrepresentable, e.Parts...)
+		} else {
+			return option, err
+		}
+	}
+	if len(unrepresentable) != 0 {
+		return option, &UnrepresentableError{Parts: unrepresentable}
+	}
+
+	switch {
+	case second.All:
+		option.Freq = rrule.SECONDLY
+	case minute.All:
+		option.Freq = rrule.MINUTELY
+	case hour.All:
+		option.Freq = rrule.HOURLY
+	default:
+		option.Freq = rrule.DAILY
+	}
+
+	if !second.All {
+		option.Bysecond = second.Values
+	}
+	if !minute.All {
+		option.Byminute = minute.Values
+	}
+	if !hour.All {
+		option.Byhour = hour.Values
+	}
+	if !month.All {
+		option.Bymonth = month.Values
+	}
+	if !day.All {
+		for _, d := range day.Values {
+			if last {
+				d = -d
+			}
+			option.Bymonthday = append(option.Bymonthday, d)
+		}
+	}
+	if !weekday.All {
+		for _, d := range weekday.Values {
+			option.Byweekday = append(option.Byweekday, weekdays[d])
+		}
+	}
+
+	return option, nil
+}
+
+// ToSet converts the calendar event expression expr to a set with the rule of
+// ToROption.
+func ToSet(expr string, dtstart time.Time) (*rrule.Set, error) {
+	option, err := ToROption(expr, dtstart)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := rrule.NewRRule(option)
+	if err != nil {
+		return nil, fmt.Errorf("systemd: %v", err)
+	}
+
+	set := &rrule.Set{}
+	set.RRule(r)
+	return set, nil
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+// parseWeekdays parses a list of weekdays and ranges of weekdays, as numbers
+// from 0 for Monday.
+func parseWeekdays(s string) (field.Field, error) {
+	var values []int
+	for _, item := range strings.Split(s, ",") {
+		bounds := strings.SplitN(item, "..", 2)
+		from, err := parseWeekday(bounds[0])
+		if err != nil {
+			return field.Field{}, err
+		}
+		to := from
+		if len(bounds) == 2 {
+			if to, err = parseWeekday(bounds[1]); err != nil {
+				return field.Field{}, err
+			}
+		}
+		if to < from {
+			return field.Field{}, fmt.Errorf("systemd: bad range of weekdays %q", item)
+		}
+		for d := from; d <= to; d++ {
+			values = append(values, d)
+		}
+	}
+
+	values = field.Unique(values)
+	return field.Field{Values: values, All: len(values) == 7}, nil
+}
+
+func parseWeekday(s string) (int, error) {
+	for d := range weekdayNames {
+		if strings.EqualFold(s, weekdayNames[d]) || strings.EqualFold(s, fullWeekdayNames[d]) {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("systemd: bad weekday %q", s)
+}
+
+// parseDate parses a date [year-]month-day or [year-]month~day, where last tells
+// the days are counted from the end of the month.
+func parseDate(s string) (year, month, day field.Field, last bool, err error) {
+	if i := strings.LastIndex(s, "~"); i >= 0 {
+		last = true
+		s = s[:i] + "-" + s[i+1:]
+	}
+
+	parts := strings.Split(s, "-")
+	switch len(parts) {
+	case 2:
+		parts = append([]string{"*"}, parts...)
+	case 3:
+	default:
+		return year, month, day, last, fmt.Errorf("systemd: bad date %q", s)
+	}
+
+	if year, err = parseField(parts[0], 1970, rrule.MAXYEAR, false); err != nil {
+		return
+	}
+	if month, err = parseField(parts[1], 1, 12, false); err != nil {
+		return
+	}
+	day, err = parseField(parts[2], 1, 31, last)
+	return
+}
+
+// parseTime parses a time hour:minute[:second].
+func parseTime(s string) (hour, minute, second field.Field, err error) {
+	parts := strings.Split(s, ":")
+	switch len(parts) {
+	case 2:
+		parts = append(parts, "00")
+	case 3:
+	default:
+		return hour, minute, second, fmt.Errorf("systemd: bad time %q", s)
+	}
+
+	if hour, err = parseField(parts[0], 0, 23, false); err != nil {
+		return
+	}
+	if minute, err = parseField(parts[1], 0, 59, false); err != nil {
+		return
+	}
+	if strings.Contains(parts[2], ".") {
+		err = &UnrepresentableError{Parts: []string{"fractional seconds " + parts[2]}}
+		return
+	}
+	second, err = parseField(parts[2], 0, 59, false)
+	return
+}
+
+// parseField parses a field of values from min to max. A repetition a/n repeats
+// to max, or down to min when reversed, as for the days from the end of the
+// month.
+func parseField(s string, min, max int, reversed bool) (field.Field, error) {
+	return syntax.Parse(s, field.Bounds{Min: min, Max: max, Reversed: reversed})
+}
+
+// FromROption converts the options of a rule to a calendar event expression
+// matching its occurrences. DTSTART only supplies the parts the rule implies
+// from it, and its time zone, which is written unless it is time.Local.
+//
+// It returns an *UnrepresentableError listing the parts of the rule no
+// expression expresses: COUNT, UNTIL, BYYEARDAY, BYWEEKNO, BYEASTER, BYSETPOS,
+// an INTERVAL other than a repetition of hours, minutes or seconds dividing the
+// day evenly, nth weekdays other than those of a month without BYMONTHDAY, days
+// of the month counted from both its start and its end, and a DTSTART in a time
+// zone without a name.
+func FromROption(option rrule.ROption) (string, error) {
+	var unsupported []string
+	unsupport := func(part string) {
+		for _, p := range unsupported {
+			if p == part {
+				return
+			}
+		}
+		unsupported = append(unsupported, part)
+	}
+
+	if option.Count != 0 {
+		unsupport("COUNT")
+	}
+	if !option.Until.IsZero() {
+		unsupport("UNTIL")
+	}
+	if len(option.Bysetpos) != 0 {
+		unsupport("BYSETPOS")
+	}
+	if len(option.Byyearday) != 0 {
+		unsupport("BYYEARDAY")
+	}
+	if len(option.Byweekno) != 0 {
+		unsupport("BYWEEKNO")
+	}
+	if len(option.Byeaster) != 0 {
+		unsupport("BYEASTER")
+	}
+
+	dtstart := option.Dtstart
+	if dtstart.IsZero() {
+		dtstart = time.Now().UTC()
+	}
+	interval := option.Interval
+	if interval < 1 {
+		interval = 1
+	}
+	if interval > 1 && option.Freq < rrule.HOURLY {
+		unsupport("INTERVAL")
+	}
+
+	// timeField formats the time part of freq. A repetition of the rule
+	// frequency keeps counting across days only when it divides the day.
+	timeField := func(freq rrule.Frequency, list []int, value, units int) string {
+		switch {
+		case option.Freq == freq && interval > 1 && (len(list) != 0 || units%interval != 0):
+			unsupport("INTERVAL")
+		case len(list) != 0:
+			return syntax.Format(list, 0, units-1)
+		case option.Freq < freq:
+			return fmt.Sprintf("%02d", value)
+		case option.Freq == freq && interval > 1:
+			return fmt.Sprintf("%02d/%d", value%interval, interval)
+		}
+		return "*"
+	}
+	second := timeField(rrule.SECONDLY, option.Bysecond, dtstart.Second(), 60)
+	minute := timeField(rrule.MINUTELY, option.Byminute, dtstart.Minute(), 60)
+	hour := timeField(rrule.HOURLY, option.Byhour, dtstart.Hour(), 24)
+
+	bymonth, bymonthday, byweekday := option.Bymonth, option.Bymonthday, option.Byweekday
+	if len(bymonthday) == 0 && len(byweekday) == 0 && len(option.Byyearday) == 0 &&
+		len(option.Byweekno) == 0 && len(option.Byeaster) == 0 {
+		switch option.Freq {
+		case rrule.YEARLY:
+			if len(bymonth) == 0 {
+				bymonth = []int{int(dtstart.Month())}
+			}
+			bymonthday = []int{dtstart.Day()}
+		case rrule.MONTHLY:
+			bymonthday = []int{dtstart.Day()}
+		case rrule.WEEKLY:
+			byweekday = []rrule.Weekday{weekdays[(int(dtstart.Weekday())+6)%7]}
+		}
+	}
+
+	// Nth weekdays of a month are the weekdays of a week of days of the month.
+	monthly := option.Freq == rrule.MONTHLY || option.Freq == rrule.YEARLY && len(bymonth) != 0
+	var days []int
+	n := 0
+	for i := range byweekday {
+		days = append(days, byweekday[i].Day())
+		if option.Freq > rrule.MONTHLY || byweekday[i].N() == 0 {
+			continue
+		}
+		switch {
+		case !monthly || len(bymonthday) != 0 || n != 0 && byweekday[i].N() != n || byweekday[i].N() < -5 || byweekday[i].N() > 5:
+			unsupport("BYDAY")
+		case n == 0:
+			n = byweekday[i].N()
+		}
+	}
+	if n != 0 {
+		for i := range byweekday {
+			if byweekday[i].N() == 0 {
+				unsupport("BYDAY")
+			}
+		}
+		from := 7*(abs(n)-1) + 1
+		for d := from; d < from+7 && d <= 31; d++ {
+			if n > 0 {
+				bymonthday = append(bymonthday, d)
+			} else {
+				bymonthday = append(bymonthday, -d)
+			}
+		}
+	}
+
+	day, sep := "*", "-"
+	if len(bymonthday) != 0 {
+		var plain, last []int
+		for _, d := range bymonthday {
+			if d > 0 {
+				plain = append(plain, d)
+			} else {
+				last = append(last, -d)
+			}
+		}
+		switch {
+		case len(plain) != 0 && len(last) != 0:
+			unsupport("BYMONTHDAY")
+		case len(last) != 0:
+			day, sep = formatLastDays(last), "~"
+		default:
+			day = syntax.Format(plain, 1, 31)
+		}
+	}
+
+	zone := ""
+	if loc := dtstart.Location(); loc != time.Local {
+		if _, err := time.LoadLocation(loc.String()); err != nil || loc.String() == "" {
+			unsupport("DTSTART")
+		}
+		zone = " " + loc.String()
+	}
+
+	if len(unsupported) != 0 {
+		return "", &UnrepresentableError{Parts: unsupported}
+	}
+
+	expr := "*-" + syntax.Format(bymonth, 1, 12) + sep + day + " " + hour + ":" + minute + ":" + second + zone
+	if len(days) != 0 {
+		expr = formatWeekdays(days) + " " + expr
+	}
+	return expr, nil
+}
+
+// formatLastDays returns the days counted from the end of the month, with runs
+// of 3 or more days, down to the last day, as a repetition.
+func formatLastDays(days []int) string {
+	days = field.Unique(days)
+	if len(days) >= 3 && days[0] == 1 && field.Step(days) == 1 {
+		return fmt.Sprintf("%02d/1", days[len(days)-1])
+	}
+	return syntax.Format(days, 1, 31)
+}
+
+// formatWeekdays returns the weekdays, from 0 for Monday, with runs of 3 or more
+// weekdays as ranges.
+func formatWeekdays(days []int) string {
+	return syntax.FormatRuns(days, func(d int) string { return weekdayNames[d] })
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,238 @@
+package systemd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/xyedo/rrule"
+)
+
+var dtstart = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestToROption(t *testing.T) {
+	workdays := []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.TH, rrule.FR}
+	tests := []struct {
+		expr     string
+		expected rrule.ROption
+	}{
+		{"daily", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0}}},
+		{"minutely", rrule.ROption{Freq: rrule.MINUTELY, Bysecond: []int{0}}},
+		{"weekly", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Byweekday: []rrule.Weekday{rrule.MO}}},
+		{"quarterly", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Bymonth: []int{1, 4, 7, 10}, Bymonthday: []int{1}}},
+		{"Mon..Fri *-*-* 09:00:00", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{9},
+			Byweekday: workdays}},
+		{"monday,wednesday..thu 9:30", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{30}, Byhour: []int{9},
+			Byweekday: []rrule.Weekday{rrule.MO, rrule.WE, rrule.TH}}},
+		{"*-*-01,15 00:00", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Bymonthday: []int{1, 15}}},
+		{"*:*:*", rrule.ROption{Freq: rrule.SECONDLY}},
+		{"Mon *-05~07/1", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Bymonth: []int{5}, Bymonthday: []int{-1, -2, -3, -4, -5, -6, -7}, Byweekday: []rrule.Weekday{rrule.MO}}},
+		{"*-02~03", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{0}, Byminute: []int{0}, Byhour: []int{0},
+			Bymonth: []int{2}, Bymonthday: []int{-3}}},
+		{"01..03-10 08:00:15", rrule.ROption{Freq: rrule.DAILY, Bysecond: []int{15}, Byminute: []int{0}, Byhour: []int{8},
+			Bymonth: []int{1, 2, 3}, Bymonthday: []int{10}}},
+	}
+
+	for _, test := range tests {
+		option, err := ToROption(test.expr, dtstart)
+		if err != nil {
+			t.Errorf("%q: %v", test.expr, err)
+			continue
+		}
+		test.expected.Dtstart = dtstart
+		if !reflect.DeepEqual(option, test.expected) {
+			t.Errorf("%q: expected %+v, got %+v", test.expr, test.expected, option)
+		}
+	}
+}
+
+func TestToROptionZone(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	option, err := ToROption("Mon..Fri 09:00 Europe/Berlin", dtstart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if option.Dtstart.Location().String() != berlin.String() || !option.Dtstart.Equal(dtstart) {
+		t.Errorf("expected DTSTART %v in Europe/Berlin, got %v", dtstart, option.Dtstart)
+	}
+
+	option, _ = ToROption("daily UTC", dtstart.In(berlin))
+	if option.Dtstart.Location() != time.UTC {
+		t.Errorf("expected DTSTART in UTC, got %v", option.Dtstart)
+	}
+}
+
+func TestToROptionYears(t *testing.T) {
+	option, err := ToROption("2025..2026-03-01 12:00", dtstart)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !option.Dtstart.Equal(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected DTSTART %v", option.Dtstart)
+	}
+	if !option.Until.Equal(time.Date(2026, 12, 31, 23, 59, 59, 0, time.UTC)) {
+		t.Errorf("unexpected UNTIL %v", option.Until)
+	}
+
+	r, _ := rrule.NewRRule(option)
+	expected := []time.Time{
+		time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+		time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+	if all := r.All(); !reflect.DeepEqual(all, expected) {
+		t.Errorf("expected %v, got %v", expected, all)
+	}
+}
+
+func TestToSet(t *testing.T) {
+	set, err := ToSet("Fri *-*~07/1 17:30", dtstart)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []time.Time{
+		time.Date(2024, 1, 26, 17, 30, 0, 0, time.UTC),
+		time.Date(2024, 2, 23, 17, 30, 0, 0, time.UTC),
+		time.Date(2024, 3, 29, 17, 30, 0, 0, time.UTC),
+	}
+	var got []time.Time
+	next := set.Iterator()
+	for range expected {
+		v, _ := next()
+		got = append(got, v)
+	}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestToROptionError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"Funday",
+		"Fri..Mon",
+		"*-*-32",
+		"*-13-01",
+		"*-*-*-*",
+		"24:00",
+		"*:60",
+		"09:00 10:00",
+		"09:00 *-*-01",
+		"Mon Mon",
+	} {
+		if _, err := ToROption(expr, dtstart); err == nil {
+			t.Errorf("%q: expected an error", expr)
+		}
+	}
+
+	_, err := ToROption("2024,2026-*-01 00:00:00.5", dtstart)
+	e, ok := err.(*UnrepresentableError)
+	if !ok {
+		t.Fatalf("expected an UnrepresentableError, got %v", err)
+	}
+	expected := []string{"year 2024,2026", "fractional seconds 00.5"}
+	if !reflect.DeepEqual(e.Parts, expected) {
+		t.Errorf("expected parts %v, got %v", expected, e.Parts)
+	}
+	if e.Error() != "systemd: cannot express year 2024,2026, fractional seconds 00.5" {
+		t.Errorf("unexpected message %q", e.Error())
+	}
+}
+
+func TestFromROption(t *testing.T) {
+	local := time.Date(2024, 1, 1, 0, 0, 0, 0, time.Local)
+	tests := []struct {
+		option   rrule.ROption
+		expected string
+	}{
+		{rrule.ROption{Freq: rrule.DAILY, Dtstart: time.Date(2024, 1, 1, 9, 30, 0, 0, time.UTC)}, "*-*-* 09:30:00 UTC"},
+		{rrule.ROption{Freq: rrule.WEEKLY, Dtstart: time.Date(2024, 1, 7, 9, 30, 0, 0, time.Local)}, "Sun *-*-* 09:30:00"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Dtstart: time.Date(2024, 1, 5, 9, 30, 0, 0, time.Local)}, "*-*-05 09:30:00"},
+		{rrule.ROption{Freq: rrule.YEARLY, Dtstart: time.Date(2024, 3, 5, 9, 30, 0, 0, time.Local)}, "*-03-05 09:30:00"},
+		{rrule.ROption{Freq: rrule.HOURLY, Interval: 6, Dtstart: time.Date(2024, 1, 1, 2, 0, 0, 0, time.Local)}, "*-*-* 02/6:00:00"},
+		{rrule.ROption{Freq: rrule.MINUTELY, Interval: 15, Byhour: []int{9, 10, 11, 17}, Dtstart: local}, "*-*-* 09..11,17:00/15:00"},
+		{rrule.ROption{Freq: rrule.SECONDLY, Dtstart: local}, "*-*-* *:*:*"},
+		{rrule.ROption{Freq: rrule.DAILY, Byweekday: []rrule.Weekday{rrule.MO, rrule.TU, rrule.WE, rrule.FR}, Dtstart: local},
+			"Mon..Wed,Fri *-*-* 00:00:00"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Bymonthday: []int{-1, -3}, Byhour: []int{12}, Dtstart: local}, "*-*~01,03 12:00:00"},
+		{rrule.ROption{Freq: rrule.MONTHLY, Byweekday: []rrule.Weekday{rrule.FR.Nth(-1)}, Byhour: []int{17}, Byminute: []int{30},
+			Dtstart: local}, "Fri *-*~07/1 17:30:00"},
+		{rrule.ROption{Freq: rrule.YEARLY, Bymonth: []int{11}, Byweekday: []rrule.Weekday{rrule.TH.Nth(4)}, Dtstart: local},
+			"Thu *-11-22..28 00:00:00"},
+		{rrule.ROption{Freq: rrule.WEEKLY, Byweekday: []rrule.Weekday{rrule.TH.Nth(4)}, Dtstart: local}, "Thu *-*-* 00:00:00"},
+	}
+
+	for _, test := range tests {
+		expr, err := FromROption(test.option)
+		if err != nil {
+			t.Errorf("%v: %v", test.option, err)
+		} else if expr != test.expected {
+			t.Errorf("expected %q, got %q", test.expected, expr)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, expr := range []string{
+		"Mon..Fri *-*-* 09:00:00",
+		"*-*-01,15 00:00:00",
+		"*-*-* *:00/15:00",
+		"*-01/3-01 06:00:00",
+		"Sat,Sun *-*~07/1 10:30:45",
+		"*-*-* *:*:*",
+		"*-*-* 08:00:00 UTC",
+	} {
+		option, err := ToROption(expr, dtstart.In(time.Local))
+		if err != nil {
+			t.Errorf("%q: %v", expr, err)
+			continue
+		}
+		got, err := FromROption(option)
+		if err != nil {
+			t.Errorf("%q: %v", expr, err)
+		} else if got != expr {
+			t.Errorf("expected %q, got %q", expr, got)
+		}
+	}
+}
+
+func TestFromROptionUnrepresentable(t *testing.T) {
+	tests := []struct {
+		option   rrule.ROption
+		expected []string
+	}{
+		{rrule.ROption{Freq: rrule.DAILY, Count: 3, Until: dtstart, Bysetpos: []int{1}, Dtstart: dtstart},
+			[]string{"COUNT", "UNTIL", "BYSETPOS"}},
+		{rrule.ROption{Freq: rrule.DAILY, Interval: 2, Dtstart: dtstart}, []string{"INTERVAL"}},
+		{rrule.ROption{Freq: rrule.HOURLY, Interval: 5, Dtstart: dtstart}, []string{"INTERVAL"}},
+		{rrule.ROption{Freq: rrule.MONTHLY, Bymonthday: []int{1, -1}, Dtstart: dtstart}, []string{"BYMONTHDAY"}},
+		{rrule.ROption{Freq: rrule.MONTHLY, Bymonthday: []int{1}, Byweekday: []rrule.Weekday{rrule.MO.Nth(1)}, Dtstart: dtstart},
+			[]string{"BYDAY"}},
+		{rrule.ROption{Freq: rrule.MONTHLY, Byweekday: []rrule.Weekday{rrule.MO.Nth(1), rrule.FR.Nth(-1)}, Dtstart: dtstart},
+			[]string{"BYDAY"}},
+		{rrule.ROption{Freq: rrule.YEARLY, Byweekday: []rrule.Weekday{rrule.MO.Nth(20)}, Dtstart: dtstart}, []string{"BYDAY"}},
+		{rrule.ROption{Freq: rrule.YEARLY, Byyearday: []int{1}, Byweekno: []int{1}, Byeaster: []int{0}, Dtstart: dtstart},
+			[]string{"BYYEARDAY", "BYWEEKNO", "BYEASTER"}},
+		{rrule.ROption{Freq: rrule.DAILY, Dtstart: dtstart.In(time.FixedZone("", 3600))}, []string{"DTSTART"}},
+	}
+
+	for _, test := range tests {
+		_, err := FromROption(test.option)
+		e, ok := err.(*UnrepresentableError)
+		if !ok {
+			t.Errorf("%v: expected an UnrepresentableError, got %v", test.option, err)
+			continue
+		}
+		if !reflect.DeepEqual(e.Parts, test.expected) {
+			t.Errorf("expected parts %v, got %v", test.expected, e.Parts)
+		}
+	}
+}
@@ -0,0 +1,232 @@
+package rrule
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// maxSleep is the longest a Ticker waits without reading the wall clock again,
+// so that it follows the clock when it is set or jumps.
+const maxSleep = time.Minute
+
+// Schedule is the occurrences a Ticker fires at. *RRule and *Set implement it,
+// and are stepped through with their iterators.
+type Schedule interface {
+	After(dt time.Time, inc bool) time.Time
+}
+
+// CatchUpPolicy is what a Ticker does with the occurrences it missed, because
+// the system was suspended, the wall clock jumped forward or the receiver did
+// not keep up.
+type CatchUpPolicy int
+
+const (
+	// SkipMissed drops the missed occurrences.
+	SkipMissed CatchUpPolicy = iota
+	// FireMissedOnce sends the latest of the missed occurrences, once for all
+	// of them.
+	FireMissedOnce
+	// FireAllMissed sends each of the missed occurrences, in order.
+	FireAllMissed
+)
+
+// TickerOptions are the options of a Ticker.
+type TickerOptions struct {
+	// CatchUp is what the ticker does with missed occurrences, SkipMissed by
+	// default.
+	CatchUp CatchUpPolicy
+	// Tolerance is how late an occurrence can be sent before it is missed, one
+	// second by default.
+	Tolerance time.Duration
+}
+
+// clock is the source of the wall clock time and of timers of a Ticker.
+type clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) (c <-chan time.Time, stop func() bool)
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	timer := time.NewTimer(d)
+	return timer.C, timer.Stop
+}
+
+// A Ticker sends the occurrences of a schedule on its channel when the wall
+// clock reaches them, like a time.Ticker firing at the occurrences of a rule or a
+// set. It starts with the first occurrence at or after the time it is created,
+// and sends nothing more after the last occurrence of a finite schedule.
+//
+// The wall clock is read again at least every minute, so occurrences are sent
+// on time after the clock is set or jumps: occurrences a jump forward went past
+// are missed, and the ones sent before a jump backward are not sent again.
+type Ticker struct {
+	// C is the channel the occurrences are sent on.
+	C <-chan time.Time
+
+	c     chan time.Time
+	ctx   context.Context
+	opts  TickerOptions
+	clock clock
+
+	mu   sync.Mutex
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewTicker returns a ticker sending the occurrences of s on its channel until
+// ctx is done or Stop is called. The schedule must not be changed while the
+// ticker uses it; call Reset with the changed schedule instead.
+func NewTicker(ctx context.Context, s Schedule, opts TickerOptions) *Ticker {
+	return newTicker(ctx, s, opts, systemClock{})
+}
+
+func newTicker(ctx context.Context, s Schedule, opts TickerOptions, clock clock) *Ticker {
+	if opts.Tolerance <= 0 {
+		opts.Tolerance = time.Second
+	}
+
+	c := make(chan time.Time, 1)
+	t := &Ticker{C: c, c: c, ctx: ctx, opts: opts, clock: clock}
+	t.start(s)
+	return t
+}
+
+// Stop turns the ticker off. No occurrence is received from its channel after
+// Stop returns, and the channel is not closed.
+func (t *Ticker) Stop() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.halt()
+}
+
+// Reset makes the ticker send the occurrences of s from now on instead, also
+// after Stop. No occurrence of the previous schedule is received from its
+// channel after Reset returns.
+func (t *Ticker) Reset(s Schedule) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.halt()
+	t.start(s)
+}
+
+func (t *Ticker) start(s Schedule) {
+	t.stop, t.done = make(chan struct{}), make(chan struct{})
+	go t.run(s, t.stop, t.done)
+}
+
+// halt stops the running schedule and drops the occurrence it left in the
+// channel.
+func (t *Ticker) halt() {
+	if t.stop == nil {
+		return
+	}
+
+	close(t.stop)
+	<-t.done
+	t.stop = nil
+
+	select {
+	case <-t.c:
+	default:
+	}
+}
+
+func (t *Ticker) run(s Schedule, stop, done chan struct{}) {
+	defer close(done)
+
+	now := t.clock.Now()
+	next := occurrencesFrom(s, now)
+	pending, ok := next()
+	for ; ok && pending.Before(now); pending, ok = next() {
+		if t.stopped(stop) {
+			return
+		}
+	}
+
+	for ok {
+		now := t.clock.Now()
+		if now.Before(pending) {
+			wait := pending.Sub(now)
+			if wait > maxSleep {
+				wait = maxSleep
+			}
+			c, stopTimer := t.clock.NewTimer(wait)
+			select {
+			case <-c:
+			case <-stop:
+				stopTimer()
+				return
+			case <-t.ctx.Done():
+				stopTimer()
+				return
+			}
+			continue
+		}
+
+		fire := pending
+		if t.opts.CatchUp == FireAllMissed {
+			pending, ok = next()
+		} else {
+			// The latest due occurrence stands for the missed ones before it.
+			for pending, ok = next(); ok && !now.Before(pending); pending, ok = next() {
+				if t.stopped(stop) {
+					return
+				}
+				fire = pending
+			}
+			if t.opts.CatchUp == SkipMissed && now.Sub(fire) > t.opts.Tolerance {
+				continue
+			}
+		}
+
+		select {
+		case t.c <- fire:
+		case <-stop:
+			return
+		case <-t.ctx.Done():
+			return
+		}
+	}
+}
+
+// stopped reports whether the running schedule was stopped or the context of
+// the ticker is done.
+func (t *Ticker) stopped(stop chan struct{}) bool {
+	select {
+	case <-stop:
+		return true
+	case <-t.ctx.Done():
+		return true
+	default:
+		return false
+	}
+}
+
+// occurrencesFrom returns an iterator over the occurrences of s from about
+// from, which may start with a few occurrences before it. The occurrences of
+// rules and sets are generated from the period of from rather than from DTSTART,
+// unless the rule has a COUNT.
+func occurrencesFrom(s Schedule, from time.Time) Next {
+	switch s := s.(type) {
+	case *RRule:
+		return s.iteratorFrom(from)
+	case *Set:
+		return s.iteratorFrom(from)
+	default:
+		dt, inc := from, true
+		return func() (time.Time, bool) {
+			occurrence := s.After(dt, inc)
+			dt, inc = occurrence, false
+			return occurrence, !occurrence.IsZero()
+		}
+	}
+}
@@ -0,0 +1,310 @@
+package rrule
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock is a clock set by the test, whose timers fire when it reaches them.
+type fakeClock struct {
+	mu     sync.Mutex
+	now    time.Time
+	timers map[chan time.Time]time.Time
+}
+
+func newFakeClock(now time.Time) *fakeClock {
+	return &fakeClock{now: now, timers: map[chan time.Time]time.Time{}}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) (<-chan time.Time, func() bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	ch := make(chan time.Time, 1)
+	c.timers[ch] = c.now.Add(d)
+	return ch, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		_, ok := c.timers[ch]
+		delete(c.timers, ch)
+		return ok
+	}
+}
+
+// set sets the clock to now, forward or backward, firing the timers it reaches.
+func (c *fakeClock) set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+	for ch, at := range c.timers {
+		if !now.Before(at) {
+			ch <- now
+			delete(c.timers, ch)
+		}
+	}
+}
+
+// fire fires the pending timers, as when they expire on the monotonic clock
+// after the wall clock was set back.
+func (c *fakeClock) fire() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for ch := range c.timers {
+		ch <- c.now
+		delete(c.timers, ch)
+	}
+}
+
+// waitSleeping waits until the ticker waits on a timer, after sending what was
+// due.
+func (c *fakeClock) waitSleeping(t *testing.T) {
+	t.Helper()
+	for deadline := time.Now().Add(time.Second); time.Now().Before(deadline); time.Sleep(time.Millisecond) {
+		c.mu.Lock()
+		n := len(c.timers)
+		c.mu.Unlock()
+		if n != 0 {
+			return
+		}
+	}
+	t.Fatal("ticker is not waiting")
+}
+
+func receive(t *testing.T, ticker *Ticker, expected time.Time) {
+	t.Helper()
+	select {
+	case v := <-ticker.C:
+		if !v.Equal(expected) {
+			t.Errorf("expected %v, got %v", expected, v)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected %v, got nothing", expected)
+	}
+}
+
+func receiveNothing(t *testing.T, ticker *Ticker) {
+	t.Helper()
+	select {
+	case v := <-ticker.C:
+		t.Errorf("expected nothing, got %v", v)
+	default:
+	}
+}
+
+func TestTicker(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: DAILY, Count: 3, Dtstart: time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)})
+	clock := newFakeClock(time.Date(2024, 1, 1, 9, 0, 1, 0, time.UTC))
+	ticker := newTicker(context.Background(), r, TickerOptions{}, clock)
+	defer ticker.Stop()
+
+	clock.waitSleeping(t)
+	receiveNothing(t, ticker)
+
+	clock.set(time.Date(2024, 1, 2, 8, 59, 59, 0, time.UTC))
+	clock.waitSleeping(t)
+	receiveNothing(t, ticker)
+
+	clock.set(time.Date(2024, 1, 2, 9, 0, 0, 500, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC))
+
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 3, 9, 0, 0, 0, time.UTC))
+
+	select {
+	case <-ticker.done:
+	case <-time.After(time.Second):
+		t.Error("expected the ticker to end after the last occurrence")
+	}
+}
+
+func TestTickerCatchUp(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: HOURLY, Dtstart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	tests := []struct {
+		policy   CatchUpPolicy
+		expected []time.Time
+	}{
+		{SkipMissed, nil},
+		{FireMissedOnce, []time.Time{time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC)}},
+		{FireAllMissed, []time.Time{
+			time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC),
+		}},
+	}
+
+	for _, test := range tests {
+		clock := newFakeClock(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+		ticker := newTicker(context.Background(), r, TickerOptions{CatchUp: test.policy}, clock)
+
+		clock.waitSleeping(t)
+		clock.set(time.Date(2024, 1, 1, 3, 10, 0, 0, time.UTC))
+		for _, expected := range test.expected {
+			receive(t, ticker, expected)
+		}
+		clock.waitSleeping(t)
+		receiveNothing(t, ticker)
+
+		clock.set(time.Date(2024, 1, 1, 4, 0, 0, 0, time.UTC))
+		receive(t, ticker, time.Date(2024, 1, 1, 4, 0, 0, 0, time.UTC))
+		ticker.Stop()
+	}
+}
+
+func TestTickerClockBackward(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: HOURLY, Dtstart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	clock := newFakeClock(time.Date(2024, 1, 1, 0, 59, 0, 0, time.UTC))
+	ticker := newTicker(context.Background(), r, TickerOptions{}, clock)
+	defer ticker.Stop()
+
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+
+	// The timer for 02:00 expires on time, but the wall clock was set back.
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	clock.fire()
+	clock.waitSleeping(t)
+	receiveNothing(t, ticker)
+
+	clock.set(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+	clock.waitSleeping(t)
+	receiveNothing(t, ticker)
+
+	clock.set(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC))
+}
+
+func TestTickerWakesUp(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: DAILY, Dtstart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	clock := newFakeClock(time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC))
+	ticker := newTicker(context.Background(), r, TickerOptions{}, clock)
+	defer ticker.Stop()
+
+	clock.waitSleeping(t)
+	clock.mu.Lock()
+	for _, at := range clock.timers {
+		if at.After(clock.now.Add(maxSleep)) {
+			t.Errorf("expected a timer within %v, got %v", maxSleep, at)
+		}
+	}
+	clock.mu.Unlock()
+}
+
+func TestTickerStop(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: HOURLY, Dtstart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	clock := newFakeClock(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	ticker := newTicker(context.Background(), r, TickerOptions{}, clock)
+
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+	clock.waitSleeping(t)
+	ticker.Stop()
+	ticker.Stop()
+	receiveNothing(t, ticker)
+
+	clock.set(time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC))
+	receiveNothing(t, ticker)
+
+	ticker.Reset(r)
+	defer ticker.Stop()
+	receive(t, ticker, time.Date(2024, 1, 1, 2, 0, 0, 0, time.UTC))
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 1, 3, 0, 0, 0, time.UTC))
+}
+
+func TestTickerReset(t *testing.T) {
+	hourly, _ := NewRRule(ROption{Freq: HOURLY, Dtstart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	clock := newFakeClock(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	ticker := newTicker(context.Background(), hourly, TickerOptions{}, clock)
+	defer ticker.Stop()
+
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+	clock.waitSleeping(t)
+
+	set := &Set{}
+	set.RDate(time.Date(2024, 1, 1, 1, 15, 0, 0, time.UTC))
+	set.RDate(time.Date(2024, 1, 1, 0, 45, 0, 0, time.UTC))
+	ticker.Reset(set)
+	receiveNothing(t, ticker)
+
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 1, 10, 0, 0, time.UTC))
+	clock.waitSleeping(t)
+	receiveNothing(t, ticker)
+	clock.set(time.Date(2024, 1, 1, 1, 15, 0, 0, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 1, 1, 15, 0, 0, time.UTC))
+
+	select {
+	case <-ticker.done:
+	case <-time.After(time.Second):
+		t.Error("expected the ticker to end after the last RDATE")
+	}
+}
+
+// afterOnly is a schedule implementing only After.
+type afterOnly struct {
+	r *RRule
+}
+
+func (s afterOnly) After(dt time.Time, inc bool) time.Time {
+	return s.r.After(dt, inc)
+}
+
+func TestTickerSchedule(t *testing.T) {
+	r, _ := NewRRule(ROption{Freq: MINUTELY, Interval: 30, Dtstart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)})
+	clock := newFakeClock(time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	ctx, cancel := context.WithCancel(context.Background())
+	ticker := newTicker(ctx, afterOnly{r}, TickerOptions{}, clock)
+
+	receive(t, ticker, time.Date(2024, 1, 1, 0, 30, 0, 0, time.UTC))
+	clock.waitSleeping(t)
+	clock.set(time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+	receive(t, ticker, time.Date(2024, 1, 1, 1, 0, 0, 0, time.UTC))
+
+	cancel()
+	select {
+	case <-ticker.done:
+	case <-time.After(time.Second):
+		t.Error("expected the ticker to end with its context")
+	}
+}
+
+func TestTickerFromLongAgo(t *testing.T) {
+	dtstart := time.Date(2015, 1, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 6, 1, 12, 0, 0, 500, time.UTC)
+	secondly, _ := NewRRule(ROption{Freq: SECONDLY, Dtstart: dtstart})
+	clock := newFakeClock(now)
+	ticker := newTicker(context.Background(), secondly, TickerOptions{}, clock)
+
+	clock.waitSleeping(t)
+	clock.set(now.Add(time.Second))
+	receive(t, ticker, now.Add(time.Second).Truncate(time.Second))
+
+	// A rule with COUNT is generated from DTSTART, which Stop interrupts.
+	counted, _ := NewRRule(ROption{Freq: SECONDLY, Count: 1 << 30, Dtstart: dtstart})
+	ticker.Reset(counted)
+	stopped := make(chan struct{})
+	go func() {
+		ticker.Stop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("expected Stop to return while the ticker looks for its first occurrence")
+	}
+}
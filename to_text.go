@@ -1,13 +1,17 @@
 package rrule
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
+	"golang.org/x/text/language"
 )
 
 type byweekday struct {
@@ -24,9 +28,59 @@ type TimeFormatter interface {
 	WeekDayName(Weekday) string
 }
 
+// ClockFormatter is an optional interface a TimeFormatter can implement to
+// render a time of day, e.g. "9:30 AM" or "09:30".
+// Formatters that do not implement it get a 24-hour "15:04" rendering.
+type ClockFormatter interface {
+	Clock(hour, minute, second int) string
+}
+
+// InflectingFormatter is an optional interface a TimeFormatter can implement to
+// inflect names and ordinals for the grammatical case a translation asks for, as
+// in the Russian "в первый понедельник". Message templates request a case with
+// the In method of a slot, e.g. {{.NthDaysOfWeek.In "accusative"}}; the case
+// names are agreed between the translations and the formatter.
+type InflectingFormatter interface {
+	MonthNameIn(month int, grammaticalCase string) string
+	NthIn(i int, grammaticalCase string) string
+	WeekDayNameIn(w Weekday, grammaticalCase string) string
+}
+
+// AbbreviatingFormatter is an optional interface a TimeFormatter can implement to
+// supply the abbreviated names and dates of the TextCompact style, e.g. "Mon" and
+// "Sep 2, 1997". Formatters that do not implement it get their full names.
+type AbbreviatingFormatter interface {
+	ShortFormat(time.Time) string
+	ShortMonthName(int) string
+	ShortWeekDayName(Weekday) string
+}
+
 type defaultFormatter struct{}
 
-var _ TimeFormatter = defaultFormatter{}
+var (
+	_ TimeFormatter         = defaultFormatter{}
+	_ ClockFormatter        = defaultFormatter{}
+	_ AbbreviatingFormatter = defaultFormatter{}
+)
+
+// Clock implements ClockFormatter.
+func (d defaultFormatter) Clock(hour, minute, second int) string {
+	meridiem := "AM"
+	if hour >= 12 {
+		meridiem = "PM"
+	}
+
+	hour12 := hour % 12
+	if hour12 == 0 {
+		hour12 = 12
+	}
+
+	if second != 0 {
+		return fmt.Sprintf("%d:%02d:%02d %s", hour12, minute, second, meridiem)
+	}
+
+	return fmt.Sprintf("%d:%02d %s", hour12, minute, meridiem)
+}
 
 // Format implements TimeFormatter.
 func (d defaultFormatter) Format(t time.Time) string {
@@ -98,30 +152,177 @@ func (d defaultFormatter) WeekDayName(w Weekday) string {
 	return weekday
 }
 
+// ShortFormat implements AbbreviatingFormatter.
+func (d defaultFormatter) ShortFormat(t time.Time) string {
+	return fmt.Sprintf("%s %d, %d", d.ShortMonthName(int(t.Month())), t.Day(), t.Year())
+}
+
+// ShortMonthName implements AbbreviatingFormatter.
+func (d defaultFormatter) ShortMonthName(i int) string {
+	return d.MonthName(i)[:3]
+}
+
+// ShortWeekDayName implements AbbreviatingFormatter.
+func (d defaultFormatter) ShortWeekDayName(w Weekday) string {
+	weekday := [...]string{"Mon", "Tue", "Wed", "Thu", "Fri", "Sat", "Sun"}[w.Day()]
+	if n := w.N(); n != 0 {
+		return d.Nth(n) + " " + weekday
+	}
+
+	return weekday
+}
+
+// TextStyle selects how detailed a description is.
+type TextStyle int
+
+const (
+	// TextStandard describes a rule as a sentence fragment,
+	// e.g. "every week on Monday, Wednesday for 10 times".
+	TextStandard TextStyle = iota
+	// TextCompact describes a rule as a short label with abbreviated names,
+	// e.g. "Weekly on Mon, Wed, 10 times".
+	TextCompact
+	// TextVerbose describes a rule as a full sentence mentioning its time of day,
+	// DTSTART and time zone when DTSTART is given, e.g. "Every week on Monday and
+	// Wednesday at 9:00 AM, starting September 2, 1997, 10 times".
+	TextVerbose
+)
+
+// TextOptions configures how a RRule or Set is described by ToTextWithOptions.
+type TextOptions struct {
+	// Formatter renders month names, weekday names, ordinals and dates.
+	// Defaults to English.
+	Formatter TimeFormatter
+	// Style selects the level of detail. Defaults to TextStandard.
+	Style TextStyle
+	// Languages are the preferred languages passed to the i18n localizer.
+	// Defaults to "en-US".
+	Languages []string
+	// DateLimit caps how many EXDATE and RDATE values of a Set are listed
+	// before the remainder is summarized, e.g. "and 12 other exceptions".
+	// Zero lists every date.
+	DateLimit int
+	// Fallback renders messages missing from the i18n bundle with their English
+	// default instead of returning a *LocalizeError.
+	Fallback bool
+	// Now, when set, follows the description with the next occurrence at or after
+	// Now and what remains of the recurrence, e.g.
+	// "next on October 20, 2025 (in 3 days), ends after 4 more times".
+	Now time.Time
+}
+
+func (opts TextOptions) localizer(bundle *i18n.Bundle) *textLocalizer {
+	if bundle == nil {
+		bundle = i18n.NewBundle(language.English)
+	}
+
+	langs := opts.Languages
+	if len(langs) == 0 {
+		langs = []string{"en-US"}
+	}
+
+	return newTextLocalizer(i18n.NewLocalizer(bundle, langs...), opts.Fallback)
+}
+
+func (opts TextOptions) formatter() TimeFormatter {
+	if opts.Formatter == nil {
+		return defaultFormatter{}
+	}
+
+	return opts.Formatter
+}
+
+// LocalizeError is returned when a message needed to describe a rule cannot be
+// localized, typically because the i18n bundle has no translation for it.
+type LocalizeError struct {
+	MessageID string
+	Language  language.Tag
+	Err       error
+}
+
+func (e *LocalizeError) Error() string {
+	if e.Language == language.Und {
+		return fmt.Sprintf("rrule: cannot localize message %q: %v", e.MessageID, e.Err)
+	}
+
+	return fmt.Sprintf("rrule: cannot localize message %q in %q: %v", e.MessageID, e.Language, e.Err)
+}
+
+func (e *LocalizeError) Unwrap() error {
+	return e.Err
+}
+
+// englishLocalizer renders the built-in English default messages.
+var englishLocalizer = i18n.NewLocalizer(i18n.NewBundle(language.English), "en")
+
+// textLocalizer localizes the messages of a description, remembering the first
+// error instead of panicking.
+type textLocalizer struct {
+	loc      *i18n.Localizer
+	fallback bool
+	err      error
+}
+
+func newTextLocalizer(loc *i18n.Localizer, fallback bool) *textLocalizer {
+	return &textLocalizer{
+		loc:      loc,
+		fallback: fallback,
+	}
+}
+
+func (l *textLocalizer) localize(cfg *i18n.LocalizeConfig) string {
+	text, err := l.loc.Localize(cfg)
+	if err == nil {
+		return text
+	}
+
+	if l.fallback {
+		if text, err := englishLocalizer.Localize(cfg); err == nil {
+			return text
+		}
+	}
+
+	if l.err == nil {
+		localizeErr := &LocalizeError{
+			MessageID: cfg.DefaultMessage.ID,
+			Language:  language.Und,
+			Err:       err,
+		}
+
+		var notFound *i18n.MessageNotFoundErr
+		if errors.As(err, &notFound) {
+			localizeErr.Language = notFound.Tag
+		}
+
+		l.err = localizeErr
+	}
+
+	return text
+}
+
 type toText struct {
+	*textLocalizer
+	rule       *RRule
 	bymonthday []int
 	byweekday  *byweekday
 	option     *ROption
 	origOption *ROption
-	loc        *i18n.Localizer
 	formatter  TimeFormatter
+	style      TextStyle
+	now        time.Time
 }
 
 var (
-	langAnd = &i18n.LocalizeConfig{DefaultMessage: &i18n.Message{
-		ID:          "And",
-		Description: "Used for final delimiter in list",
-		Other:       "and",
-	}}
-
-	langOr = &i18n.LocalizeConfig{DefaultMessage: &i18n.Message{
-		ID:          "Or",
-		Description: "Used for final delimiter in list",
-		Other:       "or",
-	}}
+	langAnd = &i18n.LocalizeConfig{DefaultMessage: msgAnd}
+
+	langOr = &i18n.LocalizeConfig{DefaultMessage: msgOr}
+
+	langDaysOfWeekAnd = &i18n.LocalizeConfig{DefaultMessage: msgDaysOfWeekAnd}
+
+	langListDelimiter = &i18n.LocalizeConfig{DefaultMessage: msgListDelimiter}
 )
 
-func newToText(rule *RRule, loc *i18n.Localizer, formatter TimeFormatter) *toText {
+func newToText(rule *RRule, loc *textLocalizer, opts TextOptions) *toText {
 	var byMonthDay []int
 	if len(rule.OrigOptions.Bymonthday) > 0 {
 		pos := make([]int, 0, len(rule.Options.Bymonthday))
@@ -177,7 +378,9 @@ func newToText(rule *RRule, loc *i18n.Localizer, formatter TimeFormatter) *toTex
 		sort.Sort(weekDays(someWeeks))
 
 		return &toText{
-			bymonthday: byMonthDay,
+			textLocalizer: loc,
+			rule:          rule,
+			bymonthday:    byMonthDay,
 			byweekday: &byweekday{
 				allWeeks:   allWeeks,
 				someWeeks:  someWeeks,
@@ -186,688 +389,746 @@ func newToText(rule *RRule, loc *i18n.Localizer, formatter TimeFormatter) *toTex
 			},
 			option:     &rule.Options,
 			origOption: &rule.OrigOptions,
-			loc:        loc,
-			formatter:  formatter,
+			formatter:  opts.formatter(),
+			style:      opts.Style,
+			now:        opts.Now,
 		}
 	}
 
 	return &toText{
-		bymonthday: byMonthDay,
-		byweekday:  nil,
-		option:     &rule.Options,
-		origOption: &rule.OrigOptions,
-		loc:        loc,
-		formatter:  formatter,
-	}
-}
-
-func (t *toText) ToString() string {
-	var text strings.Builder
-	text.WriteString(
-		t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Every",
-				Other: "every",
-			},
-		}))
+		textLocalizer: loc,
+		rule:          rule,
+		bymonthday:    byMonthDay,
+		byweekday:     nil,
+		option:        &rule.Options,
+		origOption:    &rule.OrigOptions,
+		formatter:     opts.formatter(),
+		style:         opts.Style,
+		now:           opts.Now,
+	}
+}
+
+// ToString describes the rule, returning the first localization error, if any.
+func (t *toText) ToString() (string, error) {
+	slots := map[string]interface{}{
+		"Frequency": t.frequency(),
+		"Months":    "",
+		"Days":      "",
+		"YearDays":  "",
+		"Weeks":     "",
+		"Time":      "",
+		"End":       t.end(),
+	}
 
 	switch t.option.Freq {
-	case MINUTELY:
-		t.minutely(&text)
-	case HOURLY:
-		t.hourly(&text)
-	case DAILY:
-		t.daily(&text)
-	case WEEKLY:
-		t.weekly(&text)
+	case YEARLY:
+		slots["Days"] = t.days(true)
+		slots["YearDays"] = t.yearDays()
+		slots["Weeks"] = t.weeks()
 	case MONTHLY:
-		t.monthly(&text)
+		slots["Days"] = t.days(true)
+	case WEEKLY:
+		if t.byweekday == nil || !t.byweekday.isWeekdays && !t.byweekday.isEveryDay {
+			slots["Months"] = t.inMonths()
+			slots["Days"] = t.days(false)
+		}
+	case DAILY:
+		slots["Months"] = t.inMonths()
+		slots["Days"] = t.days(false)
+	}
+
+	if t.option.Freq <= DAILY {
+		slots["Time"] = t.byTime()
+	}
+
+	msg := msgRule
+	switch t.style {
+	case TextCompact:
+		msg = msgCompactRule
+	case TextVerbose:
+		msg = msgVerboseRule
+		slots["Start"] = t.start()
+	}
+
+	text := t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msg,
+		TemplateData:   slots,
+	})
+
+	if !t.now.IsZero() {
+		text = t.status(text, t.relative(), t.formatter, t.style)
+	}
+
+	return capitalize(text, t.style), t.err
+}
+
+// relative locates the rule relative to the reference time: COUNT rules count the
+// occurrences left, UNTIL rules give the end date.
+func (t *toText) relative() textRelative {
+	rel := textRelative{
+		now:   t.now,
+		next:  t.rule.After(t.now, true),
+		count: -1,
+	}
+
+	switch {
+	case t.option.Count > 0:
+		rel.count = countFrom(t.rule.Iterator(), t.now)
+	case !t.option.Until.IsZero():
+		rel.until = t.option.Until
+	}
+
+	return rel
+}
+
+// frequency describes FREQ and INTERVAL, along with BYMONTH for monthly and
+// yearly rules, e.g. "every 2 weeks".
+func (t *toText) frequency() string {
+	var msg *i18n.Message
+	switch t.option.Freq {
 	case YEARLY:
-		t.yearly(&text)
+		msg = msgYearly
+		if len(t.origOption.Bymonth) > 0 {
+			msg = msgYearlyInMonths
+		}
+	case MONTHLY:
+		msg = msgMonthly
+		if len(t.origOption.Bymonth) > 0 {
+			msg = msgMonthlyInMonths
+		}
+	case WEEKLY:
+		msg = msgWeekly
+		if t.byweekday != nil && t.byweekday.isWeekdays {
+			msg = msgWeeklyOnWeekdays
+		} else if t.byweekday != nil && t.byweekday.isEveryDay {
+			msg = msgWeeklyOnEveryDay
+		}
+	case DAILY:
+		msg = msgDaily
+		if t.byweekday != nil && t.byweekday.isWeekdays {
+			msg = msgDailyOnWeekdays
+		}
+	case HOURLY:
+		msg = msgHourly
+	case MINUTELY:
+		msg = msgMinutely
+	default:
+		msg = msgSecondly
 	}
-	if !t.option.Until.IsZero() {
-		text.WriteString(" ")
-		text.WriteString(
-			t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "Until",
-					Other: "until",
-				},
-			}))
-		text.WriteString(" ")
-		text.WriteString(t.formatter.Format(t.option.Until))
-
-	} else if t.option.Count > 0 {
-		text.WriteString(" ")
-		text.WriteString(
-			t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "TimeCount",
-					One:   "for {{.Count}} time",
-					Two:   "for {{.Count}} times",
-					Few:   "for {{.Count}} times",
-					Many:  "for {{.Count}} times",
-					Other: "for {{.Count}} times",
-				},
-				TemplateData: map[string]interface{}{
-					"Count": t.option.Count,
-				},
-				PluralCount: t.option.Count,
-			}),
-		)
+
+	if compact, ok := compactFrequencies[msg]; ok && t.style == TextCompact {
+		msg = compact
 	}
 
-	return text.String()
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msg,
+		TemplateData: map[string]interface{}{
+			"Interval": t.option.Interval,
+			"Months":   t.months(),
+		},
+		PluralCount: t.option.Interval,
+	})
 }
 
-func (t *toText) hourly(sb *strings.Builder) {
-	if t.option.Interval != 1 {
-		sb.WriteByte(' ')
-		sb.WriteString(strconv.Itoa(t.option.Interval))
+// inMonths describes BYMONTH of daily and weekly rules, e.g. "in January".
+func (t *toText) inMonths() string {
+	if len(t.origOption.Bymonth) == 0 {
+		return ""
 	}
 
-	sb.WriteByte(' ')
-	sb.WriteString(
-		t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Hour",
-				One:   "hour",
-				Two:   "hours",
-				Few:   "hours",
-				Many:  "hours",
-				Other: "hours",
-			},
-			PluralCount: t.option.Interval,
-		}),
-	)
-}
-
-func (t *toText) minutely(sb *strings.Builder) {
-	if t.option.Interval != 1 {
-		sb.WriteByte(' ')
-		sb.WriteString(strconv.Itoa(t.option.Interval))
-	}
-
-	sb.WriteByte(' ')
-	sb.WriteString(
-		t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Minute",
-				One:   "minute",
-				Two:   "minutes",
-				Few:   "minutes",
-				Many:  "minutes",
-				Other: "minutes",
-			},
-			PluralCount: t.option.Interval,
-		}),
-	)
-}
-
-func (t *toText) yearly(sb *strings.Builder) {
-	if len(t.origOption.Bymonth) > 0 {
-		if t.option.Interval != 1 {
-			sb.WriteByte(' ')
-			sb.WriteString(strconv.Itoa(t.option.Interval))
-
-			sb.WriteByte(' ')
-			sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "Year",
-					One:   "year",
-					Two:   "years",
-					Few:   "years",
-					Many:  "years",
-					Other: "years",
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgInMonths,
+		TemplateData: map[string]interface{}{
+			"Months": t.months(),
+		},
+	})
+}
+
+// days describes BYMONTHDAY and BYDAY, e.g. "on the 2nd Monday". A rule on
+// Monday to Friday is described as on weekdays if onWeekdays is set, otherwise
+// its frequency already says so.
+func (t *toText) days(onWeekdays bool) string {
+	if len(t.bymonthday) > 0 {
+		if t.byweekday != nil && len(t.byweekday.allWeeks) > 0 {
+			return t.localize(&i18n.LocalizeConfig{
+				DefaultMessage: msgOnDaysOfWeekTheMonthDays,
+				TemplateData: map[string]interface{}{
+					"DaysOfWeek": t.daysOfWeek(t.byweekday.allWeeks, langOr),
+					"MonthDays":  t.nths(t.bymonthday, langOr),
 				},
-				PluralCount: t.option.Interval,
-			}))
+			})
 		}
 
-		t.byMonth(sb)
-	} else {
-		if t.option.Interval != 1 {
-			sb.WriteByte(' ')
-			sb.WriteString(strconv.Itoa(t.option.Interval))
+		return t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgOnTheMonthDays,
+			TemplateData: map[string]interface{}{
+				"MonthDays": t.nths(t.bymonthday, langAnd),
+			},
+		})
+	}
+
+	if t.byweekday == nil {
+		return ""
+	}
+
+	allWeeks := t.byweekday.allWeeks
+	if t.byweekday.isWeekdays {
+		if onWeekdays {
+			return t.localize(&i18n.LocalizeConfig{
+				DefaultMessage: msgOnWeekdays,
+			})
 		}
 
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Year",
-				One:   "year",
-				Two:   "years",
-				Few:   "years",
-				Many:  "years",
-				Other: "years",
-			},
-			PluralCount: t.option.Interval,
-		}))
+		allWeeks = nil
 	}
 
-	if len(t.bymonthday) > 0 {
-		t.byMonthDay(sb)
-	} else if t.byweekday != nil {
-		t.byWeekDay(sb)
+	var msg *i18n.Message
+	switch {
+	case len(allWeeks) > 0 && len(t.byweekday.someWeeks) > 0:
+		msg = msgOnDaysOfWeekAndTheNth
+	case len(allWeeks) > 0:
+		msg = msgOnDaysOfWeek
+	case len(t.byweekday.someWeeks) > 0:
+		msg = msgOnTheNthDaysOfWeek
+	default:
+		return ""
 	}
 
-	if len(t.option.Byyearday) > 0 {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "OnTheYearDay",
-				Other: "on the",
-			},
-		}))
+	// In English, only a verbose description says "Monday and Wednesday" rather
+	// than "Monday, Wednesday"; other languages may always use a conjunction.
+	final := langDaysOfWeekAnd
+	if t.style == TextVerbose {
+		final = langAnd
+	}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.list(
-				t.option.Byyearday,
-				t.formatter.Nth,
-				t.loc.MustLocalize(langAnd),
-				",",
-			),
-		)
-
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "ByYearDay",
-				Other: "day",
-			},
-		}))
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msg,
+		TemplateData: map[string]interface{}{
+			"DaysOfWeek":    t.daysOfWeek(allWeeks, final),
+			"NthDaysOfWeek": t.daysOfWeek(t.byweekday.someWeeks, langAnd),
+		},
+	})
+}
+
+// yearDays describes BYYEARDAY, e.g. "on the 100th day".
+func (t *toText) yearDays() string {
+	if len(t.option.Byyearday) == 0 {
+		return ""
 	}
 
-	if len(t.option.Byweekno) > 0 {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "InWeekNo",
-				Other: "in",
-			},
-		}))
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgOnTheYearDays,
+		TemplateData: map[string]interface{}{
+			"YearDays": t.nths(t.option.Byyearday, langAnd),
+		},
+	})
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "ByWeekNo",
-				One:   "week",
-				Two:   "weeks",
-				Few:   "weeks",
-				Many:  "weeks",
-				Other: "weeks",
-			},
-			PluralCount: len(t.option.Byweekno),
-		}))
+// weeks describes BYWEEKNO, e.g. "in weeks 1 and 2".
+func (t *toText) weeks() string {
+	if len(t.option.Byweekno) == 0 {
+		return ""
+	}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.list(
-				t.option.Byweekno,
-				func(i int) string {
-					return strconv.Itoa(i)
-				},
-				t.loc.MustLocalize(langAnd),
-				",",
-			),
-		)
-	}
-}
-func (t *toText) monthly(sb *strings.Builder) {
-	if len(t.origOption.Bymonth) > 0 {
-		if t.option.Interval != 1 {
-			sb.WriteByte(' ')
-			sb.WriteString(strconv.Itoa(t.option.Interval))
-
-			sb.WriteByte(' ')
-			sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "Month",
-					One:   "month",
-					Two:   "months",
-					Few:   "months",
-					Many:  "months",
-					Other: "months",
-				},
-				PluralCount: len(t.origOption.Bymonth),
-			}))
-
-			if t.option.Interval > 1 {
-				sb.WriteByte(' ')
-				sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-					DefaultMessage: &i18n.Message{
-						ID:    "InMonthly",
-						Other: "in",
-					},
-				}))
-			}
-		}
+	weeks := t.option.Byweekno
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgInWeeks,
+		TemplateData: map[string]interface{}{
+			"Weeks": t.slot(len(weeks), func(i int, _ string) string {
+				return strconv.Itoa(weeks[i])
+			}, langAnd),
+		},
+		PluralCount: len(weeks),
+	})
+}
 
-		t.byMonth(sb)
+// byTime describes the times of day an occurrence happens at, combining
+// BYHOUR, BYMINUTE and BYSECOND. Without BYHOUR the time is only mentioned
+// when DTSTART was given explicitly.
+func (t *toText) byTime() string {
+	times := t.clockTimes()
+	if len(times) == 0 {
+		return ""
+	}
 
-	} else {
-		if t.option.Interval != 1 {
-			sb.WriteByte(' ')
-			sb.WriteString(strconv.Itoa(t.option.Interval))
-		}
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgAtTimes,
+		TemplateData: map[string]interface{}{
+			"Times": t.slot(len(times), func(i int, _ string) string {
+				return t.clock(times[i])
+			}, langAnd),
+		},
+	})
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Month",
-				One:   "month",
-				Two:   "months",
-				Few:   "months",
-				Many:  "months",
-				Other: "months",
-			},
-			PluralCount: t.option.Interval,
-		}))
+// start describes DTSTART, along with its time zone unless it is UTC. It is only
+// mentioned when it was given explicitly, rather than defaulted to now.
+func (t *toText) start() string {
+	dtstart := t.origOption.Dtstart
+	if dtstart.IsZero() {
+		return ""
 	}
 
-	if len(t.bymonthday) > 0 {
-		t.byMonthDay(sb)
-	} else if t.byweekday != nil && t.byweekday.isWeekdays {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "OnWeekDay",
-				Other: "on",
-			},
-		}))
+	data := map[string]interface{}{
+		"Date": formatDate(t.formatter, t.style, dtstart),
+	}
+
+	if zone := dtstart.Location(); zone != time.UTC {
+		data["TimeZone"] = zone.String()
+		return t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgStartingInZone,
+			TemplateData:   data,
+		})
+	}
 
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Weekday",
-				One:   "weekday",
-				Two:   "weekdays",
-				Few:   "weekdays",
-				Many:  "weekdays",
-				Other: "weekdays",
+	return t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgStarting,
+		TemplateData:   data,
+	})
+}
+
+// end describes UNTIL or COUNT.
+func (t *toText) end() string {
+	if !t.option.Until.IsZero() {
+		return t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgUntilDate,
+			TemplateData: map[string]interface{}{
+				"Date": formatDate(t.formatter, t.style, t.option.Until),
 			},
-		}))
-	} else if t.byweekday != nil {
-		t.byWeekDay(sb)
-	}
-}
-func (t *toText) weekly(sb *strings.Builder) {
-	if t.option.Interval != 1 {
-		sb.WriteByte(' ')
-		sb.WriteString(strconv.Itoa(t.option.Interval))
-
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "Week",
-				One:   "week",
-				Two:   "weeks",
-				Few:   "weeks",
-				Many:  "weeks",
-				Other: "weeks",
+		})
+	}
+
+	if t.option.Count > 0 {
+		msg := msgTimeCount
+		if t.style != TextStandard {
+			msg = msgCountTimes
+		}
+
+		return t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msg,
+			TemplateData: map[string]interface{}{
+				"Count": t.option.Count,
 			},
-			PluralCount: t.option.Interval,
-		}))
+			PluralCount: t.option.Count,
+		})
 	}
 
-	if t.byweekday != nil && t.byweekday.isWeekdays {
-		if t.option.Interval == 1 {
-			sb.WriteByte(' ')
-			sb.WriteString(
-				t.loc.MustLocalize(&i18n.LocalizeConfig{
-					DefaultMessage: &i18n.Message{
-						ID:    "Weekday",
-						One:   "weekday",
-						Two:   "weekdays",
-						Few:   "weekdays",
-						Many:  "weekdays",
-						Other: "weekdays",
-					},
-					PluralCount: t.option.Interval,
-				}),
-			)
-		} else {
-			sb.WriteByte(' ')
-			sb.WriteString(
-				t.loc.MustLocalize(&i18n.LocalizeConfig{
-					DefaultMessage: &i18n.Message{
-						ID:    "OnWeekDay",
-						Other: "on",
-					},
-				}),
-			)
-
-			sb.WriteByte(' ')
-			sb.WriteString(
-				t.loc.MustLocalize(&i18n.LocalizeConfig{
-					DefaultMessage: &i18n.Message{
-						ID:    "Weekday",
-						One:   "weekday",
-						Two:   "weekdays",
-						Few:   "weekdays",
-						Many:  "weekdays",
-						Other: "weekdays",
-					},
-					PluralCount: t.option.Interval,
-				}),
-			)
+	return ""
+}
 
-		}
-	} else if t.byweekday != nil && t.byweekday.isEveryDay {
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "EveryDay",
-					One:   "day",
-					Two:   "days",
-					Few:   "days",
-					Many:  "days",
-					Other: "days",
-				},
-				PluralCount: t.option.Interval,
-			}),
-		)
-	} else {
-		if t.option.Interval == 1 {
-			sb.WriteByte(' ')
-			sb.WriteString(
-				t.loc.MustLocalize(&i18n.LocalizeConfig{
-					DefaultMessage: &i18n.Message{
-						ID:    "Week",
-						One:   "week",
-						Two:   "weeks",
-						Few:   "weeks",
-						Many:  "weeks",
-						Other: "weeks",
-					},
-					PluralCount: t.option.Interval,
-				}),
-			)
-		}
+// clockTimes returns the sorted times of day of the rule as seconds since midnight.
+func (t *toText) clockTimes() []int {
+	dtstart := t.origOption.Dtstart
+	explicit := !dtstart.IsZero()
 
-		if len(t.origOption.Bymonth) > 0 {
-			sb.WriteByte(' ')
-			sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "InMonthly",
-					Other: "in",
-				},
-			}))
+	hours := t.origOption.Byhour
+	if len(hours) == 0 {
+		if !explicit {
+			return nil
+		}
+		hours = []int{dtstart.Hour()}
+	}
 
-			t.byMonth(sb)
+	minutes := t.origOption.Byminute
+	if len(minutes) == 0 {
+		minutes = []int{0}
+		if explicit {
+			minutes = []int{dtstart.Minute()}
 		}
+	}
 
-		if len(t.bymonthday) > 0 {
-			t.byMonthDay(sb)
-		} else if t.byweekday != nil {
-			t.byWeekDay(sb)
+	seconds := t.origOption.Bysecond
+	if len(seconds) == 0 {
+		seconds = []int{0}
+		if explicit {
+			seconds = []int{dtstart.Second()}
 		}
+	}
 
-		if len(t.origOption.Byhour) > 0 {
-			t.byHour(sb)
+	times := make([]int, 0, len(hours)*len(minutes)*len(seconds))
+	for _, hour := range hours {
+		for _, minute := range minutes {
+			for _, second := range seconds {
+				tod := hour*3600 + minute*60 + second
+				if !contains(times, tod) {
+					times = append(times, tod)
+				}
+			}
 		}
 	}
+	sort.Ints(times)
 
+	return times
 }
-func (t *toText) daily(sb *strings.Builder) {
-	if t.option.Interval != 1 {
-		sb.WriteByte(' ')
-		sb.WriteString(strconv.Itoa(t.option.Interval))
+
+// clock renders seconds since midnight with the formatter's ClockFormatter, if any.
+func (t *toText) clock(tod int) string {
+	hour, minute, second := tod/3600, tod/60%60, tod%60
+	if cf, ok := t.formatter.(ClockFormatter); ok {
+		return cf.Clock(hour, minute, second)
 	}
 
-	if t.byweekday != nil && t.byweekday.isWeekdays {
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "Weekday",
-					One:   "weekday",
-					Two:   "weekdays",
-					Few:   "weekdays",
-					Many:  "weekdays",
-					Other: "weekdays",
-				},
-				PluralCount: t.option.Interval,
-			}),
-		)
-
-	} else {
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.loc.MustLocalize(&i18n.LocalizeConfig{
-				DefaultMessage: &i18n.Message{
-					ID:    "Day",
-					One:   "day",
-					Two:   "days",
-					Few:   "days",
-					Many:  "days",
-					Other: "days",
-				},
-				PluralCount: t.option.Interval,
-			}),
-		)
+	if second != 0 {
+		return fmt.Sprintf("%02d:%02d:%02d", hour, minute, second)
 	}
 
-	if len(t.origOption.Bymonth) > 0 {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "InMonthly",
-				Other: "in",
-			},
-		}))
+	return fmt.Sprintf("%02d:%02d", hour, minute)
+}
 
-		t.byMonth(sb)
+// months lists the BYMONTH month names.
+func (t *toText) months() textSlot {
+	months := t.option.Bymonth
+	return t.slot(len(months), func(i int, grammaticalCase string) string {
+		if f, ok := t.formatter.(AbbreviatingFormatter); ok && t.style == TextCompact {
+			return f.ShortMonthName(months[i])
+		}
+		if f, ok := t.formatter.(InflectingFormatter); ok && grammaticalCase != "" {
+			return f.MonthNameIn(months[i], grammaticalCase)
+		}
+		return t.formatter.MonthName(months[i])
+	}, langAnd)
+}
+
+// nths lists numbers as ordinals, e.g. "1st, 2nd and 3rd".
+func (t *toText) nths(numbers []int, final *i18n.LocalizeConfig) textSlot {
+	return t.slot(len(numbers), func(i int, grammaticalCase string) string {
+		if f, ok := t.formatter.(InflectingFormatter); ok && grammaticalCase != "" {
+			return f.NthIn(numbers[i], grammaticalCase)
+		}
+		return t.formatter.Nth(numbers[i])
+	}, final)
+}
+
+// daysOfWeek lists weekday names, with their ordinal if any.
+func (t *toText) daysOfWeek(weekdays []Weekday, final *i18n.LocalizeConfig) textSlot {
+	return t.slot(len(weekdays), func(i int, grammaticalCase string) string {
+		if f, ok := t.formatter.(AbbreviatingFormatter); ok && t.style == TextCompact {
+			return f.ShortWeekDayName(weekdays[i])
+		}
+		if f, ok := t.formatter.(InflectingFormatter); ok && grammaticalCase != "" {
+			return f.WeekDayNameIn(weekdays[i], grammaticalCase)
+		}
+		return t.formatter.WeekDayName(weekdays[i])
+	}, final)
+}
+
+// slot lists n items rendered by item, delimited by commas and the localized final
+// delimiter, if any, e.g. "a, b and c".
+func (t *toText) slot(n int, item func(i int, grammaticalCase string) string, final *i18n.LocalizeConfig) textSlot {
+	finalDelim, delim := "", ""
+	if n > 1 {
+		delim = t.localize(langListDelimiter)
+	}
+	if final != nil && n > 1 {
+		finalDelim = t.localize(final)
+	}
+	if finalDelim == "," {
+		finalDelim = ""
 	}
 
-	if len(t.bymonthday) > 0 {
-		t.byMonthDay(sb)
-	} else if t.byweekday != nil {
-		t.byWeekDay(sb)
-	} else if len(t.origOption.Byhour) > 0 {
-		t.byHour(sb)
+	return textSlot{
+		n: n,
+		render: func(grammaticalCase string) string {
+			items := make([]string, 0, n)
+			for i := 0; i < n; i++ {
+				items = append(items, item(i, grammaticalCase))
+			}
+
+			return joinList(items, finalDelim, delim)
+		},
 	}
 }
 
-func (t *toText) byHour(sb *strings.Builder) {
-	sb.WriteByte(' ')
-	sb.WriteString(
-		t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "AtHour",
-				Other: "at",
-			},
-		}))
+// textRelative is where a recurrence stands relative to a reference time.
+type textRelative struct {
+	now time.Time
+	// next is the first occurrence at or after now, zero once the recurrence ended.
+	next time.Time
+	// count is the number of occurrences at or after now, -1 if not counted.
+	count int
+	// until is the end of the recurrence when its occurrences are not counted.
+	until time.Time
+}
 
-	sb.WriteByte(' ')
-	sb.WriteString(
-		t.list(
-			t.origOption.Byhour,
-			func(i int) string {
-				return strconv.Itoa(i)
+// status follows description with the next occurrence and what remains of the
+// recurrence, e.g. "every week, next on October 20, 2025 (in 3 days), ends after 4 more times".
+func (l *textLocalizer) status(description string, rel textRelative, formatter TimeFormatter, style TextStyle) string {
+	slots := map[string]interface{}{
+		"Description": description,
+		"Next":        "",
+		"Remaining":   "",
+	}
+
+	switch {
+	case rel.next.IsZero():
+		slots["Remaining"] = l.localize(&i18n.LocalizeConfig{DefaultMessage: msgEnded})
+	case rel.count >= 0:
+		slots["Remaining"] = l.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgEndsAfter,
+			TemplateData: map[string]interface{}{
+				"Count": rel.count,
 			},
-			t.loc.MustLocalize(langAnd),
-			",",
-		),
-	)
-
-}
-func (t *toText) byMonthDay(sb *strings.Builder) {
-	if t.byweekday != nil && len(t.byweekday.allWeeks) > 0 {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "OnAllWeek",
-				Other: "on",
+			PluralCount: rel.count,
+		})
+	case !rel.until.IsZero():
+		slots["Remaining"] = l.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgEndsOn,
+			TemplateData: map[string]interface{}{
+				"Date":     formatDate(formatter, style, rel.until),
+				"Relative": l.relativeDay(rel.now, rel.until),
 			},
-		}))
+		})
+	}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.listWeekDay(
-				t.byweekday.allWeeks,
-				t.formatter.WeekDayName,
-				t.loc.MustLocalize(langOr),
-				","),
-		)
-
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "TheAllWeek",
-				Other: "the",
+	if !rel.next.IsZero() {
+		slots["Next"] = l.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgNextOn,
+			TemplateData: map[string]interface{}{
+				"Date":     formatDate(formatter, style, rel.next),
+				"Relative": l.relativeDay(rel.now, rel.next),
 			},
-		}))
+		})
+	}
+
+	return l.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgStatus,
+		TemplateData:   slots,
+	})
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.list(t.bymonthday, t.formatter.Nth,
-				t.loc.MustLocalize(langOr),
-				","),
-		)
-	} else {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "OnTheMonthDay",
-				Other: "on the",
+// relativeDay describes the day of t relative to the day of now, in the time zone of t,
+// e.g. "tomorrow" or "in 3 days".
+func (l *textLocalizer) relativeDay(now, t time.Time) string {
+	y, m, d := now.In(t.Location()).Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	y, m, d = t.Date()
+	to := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+
+	switch days := int(to.Sub(from).Hours() / 24); {
+	case days <= 0:
+		return l.localize(&i18n.LocalizeConfig{DefaultMessage: msgToday})
+	case days == 1:
+		return l.localize(&i18n.LocalizeConfig{DefaultMessage: msgTomorrow})
+	default:
+		return l.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgInDays,
+			TemplateData: map[string]interface{}{
+				"Count": days,
 			},
-		}))
+			PluralCount: days,
+		})
+	}
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.list(t.bymonthday, t.formatter.Nth,
-				t.loc.MustLocalize(langAnd),
-				","),
-		)
+// countFrom counts the occurrences of a finite recurrence at or after now.
+func countFrom(next Next, now time.Time) int {
+	count := 0
+	for dt, ok := next(); ok; dt, ok = next() {
+		if !dt.Before(now) {
+			count++
+		}
 	}
+
+	return count
 }
 
-func (t *toText) byWeekDay(sb *strings.Builder) {
-	if len(t.byweekday.allWeeks) > 0 && !t.byweekday.isWeekdays {
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "OnWeekDay",
-				Other: "on",
-			},
-		}))
+// formatDate renders a date, abbreviated in the compact style.
+func formatDate(formatter TimeFormatter, style TextStyle, t time.Time) string {
+	if f, ok := formatter.(AbbreviatingFormatter); ok && style == TextCompact {
+		return f.ShortFormat(t)
+	}
+
+	return formatter.Format(t)
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.listWeekDay(
-				t.byweekday.allWeeks,
-				t.formatter.WeekDayName,
-				"",
-				","),
-		)
+// capitalize upper-cases the first letter of compact and verbose descriptions,
+// which stand on their own as labels and sentences.
+func capitalize(text string, style TextStyle) string {
+	if style == TextStandard || text == "" {
+		return text
 	}
 
-	if len(t.byweekday.someWeeks) > 0 {
-		if len(t.byweekday.allWeeks) > 0 {
-			sb.WriteByte(' ')
-			sb.WriteString(t.loc.MustLocalize(langAnd))
-		}
+	r, size := utf8.DecodeRuneInString(text)
+	return string(unicode.ToUpper(r)) + text[size:]
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(t.loc.MustLocalize(&i18n.LocalizeConfig{
-			DefaultMessage: &i18n.Message{
-				ID:    "OnTheWeekDay",
-				Other: "on the",
-			},
-		}))
+// textSlot is a list of names or ordinals passed to a message template. It prints
+// in the form returned by the TimeFormatter.
+type textSlot struct {
+	n      int
+	render func(grammaticalCase string) string
+}
 
-		sb.WriteByte(' ')
-		sb.WriteString(
-			t.listWeekDay(
-				t.byweekday.someWeeks,
-				t.formatter.WeekDayName,
-				t.loc.MustLocalize(langAnd),
-				",",
-			),
-		)
-	}
-}
-func (t *toText) byMonth(sb *strings.Builder) {
-	sb.WriteByte(' ')
-	sb.WriteString(
-		t.list(
-			t.option.Bymonth,
-			t.formatter.MonthName,
-			t.loc.MustLocalize(langAnd),
-			",",
-		),
-	)
-
-}
-
-func (*toText) list(numbers []int, callback func(int) string, finalDelim, delim string) string {
-	delimJoin := func(arr []string, delimiter, finalDelimiter string) string {
-		sb := strings.Builder{}
-		for i := 0; i < len(arr); i++ {
-			if i != 0 {
-				if i == len(arr)-1 {
-					sb.WriteByte(' ')
-					sb.WriteString(finalDelimiter)
-					sb.WriteByte(' ')
-				} else {
-					sb.WriteString(delimiter)
-					sb.WriteByte(' ')
-				}
+// String renders the list in the form returned by the TimeFormatter.
+func (s textSlot) String() string {
+	return s.render("")
+}
+
+// In renders the list in grammaticalCase when the TimeFormatter implements
+// InflectingFormatter.
+func (s textSlot) In(grammaticalCase string) string {
+	return s.render(grammaticalCase)
+}
+
+// Len returns the number of items of the list.
+func (s textSlot) Len() int {
+	return s.n
+}
+
+// joinList joins arr with delim, using finalDelim before the last element when given,
+// e.g. "a, b and c". finalDelim is surrounded by spaces unless delim ends without
+// one, e.g. "a、b和c".
+func joinList(arr []string, finalDelim, delim string) string {
+	if finalDelim == "" {
+		return strings.Join(arr, delim)
+	}
+	if strings.HasSuffix(delim, " ") {
+		finalDelim = " " + finalDelim + " "
+	}
+
+	sb := strings.Builder{}
+	for i := 0; i < len(arr); i++ {
+		if i != 0 {
+			if i == len(arr)-1 {
+				sb.WriteString(finalDelim)
+			} else {
+				sb.WriteString(delim)
 			}
-			sb.WriteString(arr[i])
 		}
+		sb.WriteString(arr[i])
+	}
+
+	return sb.String()
+}
+
+// setToText describes a Set as its rule followed by the excluded and the
+// additionally included dates.
+type setToText struct {
+	*textLocalizer
+	set  *Set
+	opts TextOptions
+}
+
+func newSetToText(set *Set, loc *textLocalizer, opts TextOptions) *setToText {
+	return &setToText{
+		textLocalizer: loc,
+		set:           set,
+		opts:          opts,
+	}
+}
+
+// ToString describes the set, returning the first localization error, if any.
+func (t *setToText) ToString() (string, error) {
+	rdates := sortedTimes(t.set.rdate)
+	exdates := sortedTimes(t.set.exdate)
+
+	slots := map[string]interface{}{
+		"Rule":       "",
+		"Exceptions": "",
+		"Additions":  "",
+	}
+
+	if t.set.rrule != nil {
+		opts := t.opts
+		opts.Now = time.Time{}
+		slots["Rule"], _ = newToText(t.set.rrule, t.textLocalizer, opts).ToString()
+	} else if len(rdates) > 0 {
+		slots["Rule"] = t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgOnTheDates,
+			TemplateData: map[string]interface{}{
+				"Dates": t.dates(rdates, msgOtherDates),
+			},
+		})
+
+		rdates = nil
+	}
 
-		return sb.String()
+	if len(exdates) > 0 {
+		slots["Exceptions"] = t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgExceptDates,
+			TemplateData: map[string]interface{}{
+				"Dates": t.dates(exdates, msgOtherExceptions),
+			},
+		})
 	}
 
-	cbRes := make([]string, 0, len(numbers))
-	for _, num := range numbers {
-		cbRes = append(cbRes, callback(num))
+	if len(rdates) > 0 {
+		slots["Additions"] = t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: msgPlusDates,
+			TemplateData: map[string]interface{}{
+				"Dates": t.dates(rdates, msgOtherDates),
+			},
+		})
 	}
 
-	if finalDelim != "" {
-		return delimJoin(cbRes, delim, finalDelim)
+	text := t.localize(&i18n.LocalizeConfig{
+		DefaultMessage: msgSet,
+		TemplateData:   slots,
+	})
+
+	if !t.opts.Now.IsZero() {
+		text = t.status(text, t.relative(), t.opts.formatter(), t.opts.Style)
 	}
 
-	return strings.Join(cbRes, delim+" ")
+	return capitalize(text, t.opts.Style), t.err
+}
 
+// relative locates the set relative to the reference time, counting the occurrences
+// left when the set is finite.
+func (t *setToText) relative() textRelative {
+	now := t.opts.Now
+	rel := textRelative{
+		now:   now,
+		next:  t.set.After(now, true),
+		count: -1,
+	}
+
+	if rule := t.set.rrule; rule == nil || rule.Options.Count > 0 || !rule.Options.Until.IsZero() {
+		rel.count = countFrom(t.set.Iterator(), now)
+	}
+
+	return rel
 }
 
-func (*toText) listWeekDay(weekdays []Weekday, callback func(Weekday) string, finalDelim, delim string) string {
-	delimJoin := func(arr []string, delimiter, finalDelimiter string) string {
-		sb := strings.Builder{}
-		for i := 0; i < len(arr); i++ {
-			if i != 0 {
-				if i == len(arr)-1 {
-					sb.WriteByte(' ')
-					sb.WriteString(finalDelimiter)
-					sb.WriteByte(' ')
-				} else {
-					sb.WriteString(delimiter)
-					sb.WriteByte(' ')
-				}
-			}
-			sb.WriteString(arr[i])
-		}
+// dates lists the formatted dates, summarizing those beyond the date limit with the
+// plural message rest, e.g. "January 2, 2024 and 3 other dates".
+func (t *setToText) dates(dates []time.Time, rest *i18n.Message) string {
+	shown := dates
+	if limit := t.opts.DateLimit; limit > 0 && len(dates) > limit {
+		shown = dates[:limit]
+	}
 
-		return sb.String()
+	formatter := t.opts.formatter()
+	formatted := make([]string, 0, len(shown)+1)
+	for _, date := range shown {
+		formatted = append(formatted, formatDate(formatter, t.opts.Style, date))
 	}
 
-	cbRes := make([]string, 0, len(weekdays))
-	for _, weekday := range weekdays {
-		cbRes = append(cbRes, callback(weekday))
+	if remaining := len(dates) - len(shown); remaining > 0 {
+		formatted = append(formatted, t.localize(&i18n.LocalizeConfig{
+			DefaultMessage: rest,
+			TemplateData: map[string]interface{}{
+				"Count": remaining,
+			},
+			PluralCount: remaining,
+		}))
 	}
 
-	if finalDelim != "" {
-		return delimJoin(cbRes, delim, finalDelim)
+	if len(formatted) == 1 {
+		return formatted[0]
 	}
 
-	return strings.Join(cbRes, delim+" ")
+	return joinList(formatted, t.localize(langAnd), t.localize(langListDelimiter))
+}
+
+func sortedTimes(times []time.Time) []time.Time {
+	sorted := make([]time.Time, len(times))
+	copy(sorted, times)
+	sort.Sort(timeSlice(sorted))
 
+	return sorted
 }
+
 func abs(i int) int {
 	if i < 0 {
 		return -i
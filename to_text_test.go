@@ -1,8 +1,10 @@
 package rrule
 
 import (
+	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/nicksnyder/go-i18n/v2/i18n"
 	"golang.org/x/text/language"
@@ -14,9 +16,19 @@ func TestToString(t *testing.T) {
 		rule     string
 	}{
 		{expected: "Every day", rule: "RRULE:FREQ=DAILY"},
-		{expected: "Every day at 10, 12 and 17", rule: "RRULE:FREQ=DAILY;BYHOUR=10,12,17"},
-		{expected: "Every week on Sunday at 10, 12 and 17", rule: "RRULE:FREQ=WEEKLY;BYDAY=SU;BYHOUR=10,12,17"},
+		{expected: "Every day at 10:00 AM, 12:00 PM and 5:00 PM", rule: "RRULE:FREQ=DAILY;BYHOUR=10,12,17"},
+		{expected: "Every week on Sunday at 10:00 AM, 12:00 PM and 5:00 PM", rule: "RRULE:FREQ=WEEKLY;BYDAY=SU;BYHOUR=10,12,17"},
+		{expected: "Every day at 9:30 AM", rule: "RRULE:FREQ=DAILY;BYHOUR=9;BYMINUTE=30"},
+		{expected: "Every day at 9:00 AM, 9:30 AM, 5:00 PM and 5:30 PM", rule: "RRULE:FREQ=DAILY;BYHOUR=17,9;BYMINUTE=30,0"},
+		{expected: "Every day at 12:00:15 AM", rule: "RRULE:FREQ=DAILY;BYHOUR=0;BYSECOND=15"},
+		{expected: "Every weekday at 9:30 AM", rule: "DTSTART:19970902T093000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR"},
+		{expected: "Every month on the 4th at 6:45 PM", rule: "DTSTART:19970904T184500Z\nRRULE:FREQ=MONTHLY;BYMONTHDAY=4"},
+		{expected: "Every day at 8:00 AM and 6:00 PM", rule: "DTSTART:19970904T184500Z\nRRULE:FREQ=DAILY;BYHOUR=8,18;BYMINUTE=0"},
+		{expected: "Every 4 hours", rule: "DTSTART:19970904T184500Z\nRRULE:INTERVAL=4;FREQ=HOURLY"},
 		{expected: "Every week", rule: "RRULE:FREQ=WEEKLY"},
+		{expected: "Every second", rule: "RRULE:FREQ=SECONDLY"},
+		{expected: "Every 30 seconds", rule: "RRULE:INTERVAL=30;FREQ=SECONDLY"},
+		{expected: "Every minute", rule: "RRULE:FREQ=MINUTELY"},
 		{expected: "Every hour", rule: "RRULE:FREQ=HOURLY"},
 		{expected: "Every 4 hours", rule: "RRULE:INTERVAL=4;FREQ=HOURLY"},
 		{expected: "Every week on Tuesday", rule: "RRULE:FREQ=WEEKLY;BYDAY=TU"},
@@ -46,11 +58,303 @@ func TestToString(t *testing.T) {
 			}
 
 			bundle := i18n.NewBundle(language.English)
-			loc := i18n.NewLocalizer(bundle, "en")
-			got := newToText(r, loc, defaultFormatter{}).ToString()
+			loc := newTextLocalizer(i18n.NewLocalizer(bundle, "en"), false)
+			got, err := newToText(r, loc, TextOptions{}).ToString()
+			if err != nil {
+				t.Fatalf("ToString error: %v", err)
+			}
 			if !strings.EqualFold(got, tt.expected) {
 				t.Errorf("expected %q, got %q", tt.expected, got)
 			}
 		})
 	}
 }
+
+func TestToStringClockFormatter(t *testing.T) {
+	r, err := StrToRRule("FREQ=DAILY;BYHOUR=9,17;BYMINUTE=30")
+	if err != nil {
+		t.Fatalf("failed to parse rrule: %v", err)
+	}
+
+	bundle := i18n.NewBundle(language.English)
+	loc := newTextLocalizer(i18n.NewLocalizer(bundle, "en"), false)
+
+	// A formatter without ClockFormatter falls back to 24-hour times.
+	got, err := newToText(r, loc, TextOptions{Formatter: struct{ TimeFormatter }{defaultFormatter{}}}).ToString()
+	if err != nil {
+		t.Fatalf("ToString error: %v", err)
+	}
+	if want := "every day at 09:30 and 17:30"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestToTextStyles(t *testing.T) {
+	var tests = []struct {
+		rule     string
+		style    TextStyle
+		expected string
+	}{
+		{
+			rule:     "DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10",
+			style:    TextStandard,
+			expected: "every week on Monday, Wednesday at 9:00 AM for 10 times",
+		},
+		{
+			rule:     "DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10",
+			style:    TextCompact,
+			expected: "Weekly on Mon, Wed at 9:00 AM, 10 times",
+		},
+		{
+			rule:     "DTSTART:19970902T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO,WE;COUNT=10",
+			style:    TextVerbose,
+			expected: "Every week on Monday and Wednesday at 9:00 AM, starting September 2, 1997, 10 times",
+		},
+		{
+			rule:     "FREQ=MONTHLY;INTERVAL=2;BYMONTH=1,3;UNTIL=19990101T000000Z",
+			style:    TextCompact,
+			expected: "Every 2 months in Jan and Mar, until Jan 1, 1999",
+		},
+		{
+			rule:     "DTSTART;TZID=America/New_York:19970902T090000\nRRULE:FREQ=YEARLY;COUNT=1",
+			style:    TextVerbose,
+			expected: "Every year at 9:00 AM, starting September 2, 1997 (America/New_York), once",
+		},
+		{
+			rule:     "FREQ=SECONDLY;INTERVAL=30;COUNT=4",
+			style:    TextCompact,
+			expected: "Every 30 seconds, 4 times",
+		},
+		{
+			rule:     "FREQ=WEEKLY;BYDAY=MO",
+			style:    TextVerbose,
+			expected: "Every week on Monday",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			r, err := StrToRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rrule: %v", err)
+			}
+
+			got, err := r.ToTextWithOptions(TextOptions{Style: tt.style})
+			if err != nil {
+				t.Fatalf("ToTextWithOptions error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestToTextRelative(t *testing.T) {
+	now := time.Date(2025, 10, 17, 12, 0, 0, 0, time.UTC)
+
+	var tests = []struct {
+		rule     string
+		style    TextStyle
+		expected string
+	}{
+		{
+			rule:     "DTSTART:20251006T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=6",
+			expected: "every week on Monday at 9:00 AM for 6 times, next on October 20, 2025 (in 3 days), ends after 4 more times",
+		},
+		{
+			rule:     "DTSTART:20251006T090000Z\nRRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=6",
+			style:    TextCompact,
+			expected: "Weekly on Mon at 9:00 AM, 6 times, next on Oct 20, 2025 (in 3 days), ends after 4 more times",
+		},
+		{
+			rule:     "DTSTART:20251001T090000Z\nRRULE:FREQ=DAILY;UNTIL=20251031T090000Z",
+			expected: "every day at 9:00 AM until October 31, 2025, next on October 18, 2025 (tomorrow), ends on October 31, 2025 (in 14 days)",
+		},
+		{
+			rule:     "DTSTART:20251001T180000Z\nRRULE:FREQ=DAILY",
+			expected: "every day at 6:00 PM, next on October 17, 2025 (today)",
+		},
+		{
+			rule:     "DTSTART:20251006T090000Z\nRRULE:FREQ=WEEKLY;COUNT=2",
+			expected: "every week at 9:00 AM for 2 times, ended",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			r, err := StrToRRule(tt.rule)
+			if err != nil {
+				t.Fatalf("failed to parse rrule: %v", err)
+			}
+
+			got, err := r.ToTextWithOptions(TextOptions{Style: tt.style, Now: now})
+			if err != nil {
+				t.Fatalf("ToTextWithOptions error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+
+	set, err := StrToRRuleSet("DTSTART:20251006T090000Z\nRRULE:FREQ=WEEKLY;COUNT=6\nEXDATE:20251020T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	expected := "every week at 9:00 AM for 6 times, except October 20, 2025, next on October 27, 2025 (in 10 days), ends after 3 more times"
+	if got, err := set.ToTextWithOptions(TextOptions{Now: now}); err != nil || got != expected {
+		t.Errorf("expected %q, got %q, error %v", expected, got, err)
+	}
+}
+
+type russianFormatter struct {
+	defaultFormatter
+}
+
+func (russianFormatter) MonthNameIn(month int, grammaticalCase string) string {
+	return ""
+}
+
+func (russianFormatter) NthIn(i int, grammaticalCase string) string {
+	return ""
+}
+
+func (russianFormatter) WeekDayNameIn(w Weekday, grammaticalCase string) string {
+	if w == WE.Nth(1) && grammaticalCase == "accusative" {
+		return "первую среду"
+	}
+
+	return ""
+}
+
+func TestToStringTemplates(t *testing.T) {
+	bundle := i18n.NewBundle(language.English)
+	bundle.MustAddMessages(language.Russian,
+		&i18n.Message{ID: "Rule", Other: "{{.Days}} {{.Frequency}}, {{.End}}"},
+		&i18n.Message{ID: "Monthly", One: "каждого месяца", Few: "каждые {{.Interval}} месяца", Many: "каждые {{.Interval}} месяцев", Other: "каждые {{.Interval}} месяца"},
+		&i18n.Message{ID: "OnTheNthDaysOfWeek", Other: `в {{.NthDaysOfWeek.In "accusative"}}`},
+		&i18n.Message{ID: "TimeCount", One: "{{.Count}} раз", Few: "{{.Count}} раза", Many: "{{.Count}} раз", Other: "{{.Count}} раза"},
+	)
+
+	r, err := StrToRRuleWithi18n("FREQ=MONTHLY;BYDAY=+1WE;COUNT=3", bundle)
+	if err != nil {
+		t.Fatalf("failed to parse rrule: %v", err)
+	}
+
+	got, err := r.ToTextWithOptions(TextOptions{Formatter: russianFormatter{}, Languages: []string{"ru"}})
+	if err != nil {
+		t.Fatalf("ToTextWithOptions error: %v", err)
+	}
+	if want := "в первую среду каждого месяца, 3 раза"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	// Without InflectingFormatter, slots render the plain names.
+	got, err = r.ToTextWithOptions(TextOptions{Languages: []string{"ru"}})
+	if err != nil {
+		t.Fatalf("ToTextWithOptions error: %v", err)
+	}
+	if want := "в 1st Wednesday каждого месяца, 3 раза"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestSetToText(t *testing.T) {
+	var tests = []struct {
+		expected  string
+		set       string
+		dateLimit int
+	}{
+		{
+			expected: "every week on Monday",
+			set:      "RRULE:FREQ=WEEKLY;BYDAY=MO",
+		},
+		{
+			expected: "every week on Monday, except December 25, 2023, plus January 2, 2024",
+			set:      "RRULE:FREQ=WEEKLY;BYDAY=MO\nEXDATE:20231225T090000Z\nRDATE:20240102T090000Z",
+		},
+		{
+			expected: "every day, except January 1, 2024, January 2, 2024 and January 3, 2024",
+			set:      "RRULE:FREQ=DAILY\nEXDATE:20240103T090000Z,20240101T090000Z,20240102T090000Z",
+		},
+		{
+			expected:  "every day, except January 1, 2024 and 2 other exceptions",
+			set:       "RRULE:FREQ=DAILY\nEXDATE:20240103T090000Z,20240101T090000Z,20240102T090000Z",
+			dateLimit: 1,
+		},
+		{
+			expected:  "every day, except January 1, 2024, January 2, 2024 and 1 other exception, plus February 1, 2024",
+			set:       "RRULE:FREQ=DAILY\nEXDATE:20240103T090000Z,20240101T090000Z,20240102T090000Z\nRDATE:20240201T090000Z",
+			dateLimit: 2,
+		},
+		{
+			expected: "on January 2, 2024 and March 1, 2024, except January 2, 2024",
+			set:      "RDATE:20240301T090000Z,20240102T090000Z\nEXDATE:20240102T090000Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			set, err := StrToRRuleSet(tt.set)
+			if err != nil {
+				t.Fatalf("failed to parse set: %v", err)
+			}
+
+			got, err := set.ToTextWithOptions(TextOptions{DateLimit: tt.dateLimit})
+			if err != nil {
+				t.Fatalf("ToTextWithOptions error: %v", err)
+			}
+			if got != tt.expected {
+				t.Errorf("expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSetToTextWithCustomFormatterWithoutRRule(t *testing.T) {
+	set, err := StrToRRuleSet("RDATE:20240301T090000Z,20240102T090000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	got, err := set.ToTextWithCustomFormatter(indonesianFormatter{}, "id")
+	if err != nil {
+		t.Fatalf("ToTextWithCustomFormatter error: %v", err)
+	}
+	if want := "on 2 Januari 2024 and 1 Maret 2024"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+}
+
+func TestToTextMissingMessage(t *testing.T) {
+	bundle := i18n.NewBundle(language.English)
+	bundle.MustAddMessages(language.German, &i18n.Message{ID: "Daily", One: "jeden Tag", Other: "alle {{.Interval}} Tage"})
+
+	r, err := StrToRRuleWithi18n("FREQ=DAILY;COUNT=3", bundle)
+	if err != nil {
+		t.Fatalf("failed to parse rrule: %v", err)
+	}
+
+	_, err = r.ToTextWithCustomFormatter(defaultFormatter{}, "de")
+	var localizeErr *LocalizeError
+	if !errors.As(err, &localizeErr) {
+		t.Fatalf("expected *LocalizeError, got %v", err)
+	}
+	if localizeErr.MessageID != "TimeCount" || localizeErr.Language != language.German {
+		t.Errorf("expected missing %q in %q, got %q in %q", "TimeCount", language.German, localizeErr.MessageID, localizeErr.Language)
+	}
+
+	got, err := r.ToTextWithOptions(TextOptions{Languages: []string{"de"}, Fallback: true})
+	if err != nil {
+		t.Fatalf("ToTextWithOptions error: %v", err)
+	}
+	if want := "jeden Tag for 3 times"; got != want {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	if got := r.ToText(); got != "every day for 3 times" {
+		t.Errorf("expected %q, got %q", "every day for 3 times", got)
+	}
+}
@@ -0,0 +1,124 @@
+package rrule
+
+import (
+	"time"
+)
+
+// LocationMode selects how InLocation moves a series to another time zone.
+type LocationMode int
+
+const (
+	// SameInstant keeps the instant of DTSTART, RDATE and EXDATE values. Later
+	// occurrences of the rule keep the wall clock of DTSTART in the new zone, so
+	// they differ by an hour from the original ones while only one of the zones
+	// observes daylight saving time.
+	SameInstant LocationMode = iota
+	// SameWallClock keeps the wall clock times, e.g. a 09:00 meeting in Jakarta
+	// stays at 09:00 in Berlin.
+	SameWallClock
+)
+
+// InLocation returns a copy of the rule moved to loc with mode. UNTIL, which
+// keeps its own zone, moves like the occurrences.
+//
+// With SameInstant, the parts of the rule follow DTSTART as by Shift when the
+// time of day or the day of DTSTART changes, and an error wrapping
+// ErrNotShiftable is returned when they cannot.
+func (r *RRule) InLocation(loc *time.Location, mode LocationMode) (*RRule, error) {
+	option := r.OrigOptions
+	option.Dtstart = inWallClock(r.dtstart, loc)
+	if !option.Until.IsZero() {
+		option.Until = inWallClock(option.Until.In(r.dtstart.Location()), loc).In(option.Until.Location())
+	}
+
+	rule := buildRRule(option)
+	rule.i18n = r.i18n
+	if mode == SameWallClock {
+		return &rule, nil
+	}
+
+	_, from := r.dtstart.Zone()
+	_, to := r.dtstart.In(loc).Zone()
+	return rule.Shift(time.Duration(to-from) * time.Second)
+}
+
+// InLocation returns a copy of the set moved to loc with mode. Its RRULE moves as
+// by RRule.InLocation, and its DTSTART and RDATE values as given by mode. With
+// SameWallClock, values keep their wall clock in the zone of DTSTART, e.g. an
+// RDATE given in UTC for 09:00 in Jakarta moves to 09:00 in Berlin, or in their
+// own zone without DTSTART. EXDATE values excluding an occurrence of the RRULE
+// exclude the same occurrence of the moved RRULE, and the others move as given
+// by mode.
+func (set *Set) InLocation(loc *time.Location, mode LocationMode) (*Set, error) {
+	move := func(t time.Time) time.Time {
+		if mode != SameWallClock {
+			return t.In(loc)
+		}
+		if !set.dtstart.IsZero() {
+			t = t.In(set.dtstart.Location())
+		}
+		return inWallClock(t, loc)
+	}
+
+	moved := &Set{}
+	if !set.dtstart.IsZero() {
+		moved.dtstart = move(set.dtstart)
+	}
+
+	excluded := make(map[int]time.Time)
+	if set.rrule != nil {
+		rule, err := set.rrule.InLocation(loc, mode)
+		if err != nil {
+			return nil, err
+		}
+		excluded = matchOccurrences(set.rrule, rule, set.exdate)
+		moved.RRule(rule)
+	}
+
+	for _, rdate := range set.rdate {
+		moved.rdate = append(moved.rdate, move(rdate))
+	}
+	for i, exdate := range set.exdate {
+		if occurrence, ok := excluded[i]; ok {
+			moved.exdate = append(moved.exdate, occurrence)
+		} else {
+			moved.exdate = append(moved.exdate, move(exdate))
+		}
+	}
+
+	return moved, nil
+}
+
+// matchOccurrences returns, by index in times, the occurrences of moved at the
+// index of the occurrences of r that times are.
+func matchOccurrences(r, moved *RRule, times []time.Time) map[int]time.Time {
+	matched := make(map[int]time.Time)
+	if len(times) == 0 {
+		return matched
+	}
+
+	last := sortedTimes(times)[len(times)-1]
+	next, nextMoved := r.Iterator(), moved.Iterator()
+	for dt, ok := next(); ok && !dt.After(last); dt, ok = next() {
+		movedDt, movedOk := nextMoved()
+		if !movedOk {
+			break
+		}
+
+		for i, t := range times {
+			if t.Equal(dt) {
+				matched[i] = movedDt
+			}
+		}
+	}
+
+	return matched
+}
+
+// inWallClock returns the time with the wall clock of t in loc.
+func inWallClock(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	return time.Date(year, month, day, hour, minute, second, t.Nanosecond(), loc)
+}
@@ -0,0 +1,148 @@
+package rrule
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInLocation(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+
+	var tests = []struct {
+		rule     string
+		mode     LocationMode
+		expected string
+	}{
+		{
+			"DTSTART;TZID=Asia/Jakarta:20240304T090000\nRRULE:FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+			SameWallClock,
+			"DTSTART;TZID=Europe/Berlin:20240304T090000\nRRULE:FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+		},
+		{
+			"DTSTART;TZID=Asia/Jakarta:20240304T090000\nRRULE:FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+			SameInstant,
+			"DTSTART;TZID=Europe/Berlin:20240304T030000\nRRULE:FREQ=WEEKLY;COUNT=4;BYDAY=MO,WE",
+		},
+		{
+			"DTSTART;TZID=Asia/Jakarta:20240304T050000\nRRULE:FREQ=WEEKLY;UNTIL=20240313T220000Z;BYDAY=MO,WE",
+			SameInstant,
+			"DTSTART;TZID=Europe/Berlin:20240303T230000\nRRULE:FREQ=WEEKLY;UNTIL=20240313T220000Z;BYDAY=SU,TU",
+		},
+		{
+			"DTSTART;TZID=Asia/Jakarta:20240304T090000\nRRULE:FREQ=DAILY;UNTIL=20240306T020000Z",
+			SameWallClock,
+			"DTSTART;TZID=Europe/Berlin:20240304T090000\nRRULE:FREQ=DAILY;UNTIL=20240306T080000Z",
+		},
+	}
+
+	for _, tt := range tests {
+		r, err := StrToRRule(tt.rule)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", tt.rule, err)
+		}
+
+		got, err := r.InLocation(berlin, tt.mode)
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.rule, err)
+		}
+		if got.String() != tt.expected {
+			t.Errorf("%q: expected %q, got %q", tt.rule, tt.expected, got.String())
+		}
+
+		want := r.All()
+		for i, dt := range want {
+			if tt.mode == SameWallClock {
+				want[i] = inWallClock(dt, berlin)
+			} else {
+				want[i] = dt.In(berlin)
+			}
+		}
+		if !timesEqual(got.All(), want) {
+			t.Errorf("%q: expected %v, got %v", tt.rule, want, got.All())
+		}
+	}
+}
+
+func TestSetInLocation(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	set, err := StrToRRuleSet("DTSTART;TZID=Asia/Jakarta:20240318T090000\n" +
+		"RRULE:FREQ=WEEKLY;UNTIL=20240408T020000Z;BYDAY=MO\n" +
+		"RDATE;TZID=Asia/Jakarta:20240323T100000\n" +
+		"EXDATE:20240401T020000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	got, err := set.InLocation(berlin, SameWallClock)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "DTSTART;TZID=Europe/Berlin:20240318T090000\n" +
+		"RRULE:FREQ=WEEKLY;UNTIL=20240408T070000Z;BYDAY=MO\n" +
+		"RDATE;TZID=Europe/Berlin:20240323T100000\n" +
+		"EXDATE;TZID=Europe/Berlin:20240401T090000"
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+	if len(got.All()) != len(set.All()) {
+		t.Errorf("expected %v, got %v", set.All(), got.All())
+	}
+
+	// The excluded occurrence of the rule stays excluded although Berlin moved
+	// to summer time in between.
+	got, err = set.InLocation(berlin, SameInstant)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected = "DTSTART;TZID=Europe/Berlin:20240318T030000\n" +
+		"RRULE:FREQ=WEEKLY;UNTIL=20240408T010000Z;BYDAY=MO\n" +
+		"RDATE;TZID=Europe/Berlin:20240323T040000\n" +
+		"EXDATE;TZID=Europe/Berlin:20240401T030000"
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+	if len(got.All()) != len(set.All()) {
+		t.Errorf("expected %v, got %v", set.All(), got.All())
+	}
+}
+
+func TestSetInLocationWithoutDTStart(t *testing.T) {
+	jakarta, _ := time.LoadLocation("Asia/Jakarta")
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	set := &Set{}
+	set.RDate(time.Date(2024, 3, 23, 10, 0, 0, 0, jakarta))
+	set.ExDate(time.Date(2024, 3, 24, 10, 0, 0, 0, jakarta))
+
+	got, err := set.InLocation(berlin, SameWallClock)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "RDATE;TZID=Europe/Berlin:20240323T100000\n" +
+		"EXDATE;TZID=Europe/Berlin:20240324T100000"
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+}
+
+func TestSetInLocationDatesInOtherZone(t *testing.T) {
+	set, err := StrToRRuleSet("DTSTART;TZID=Asia/Jakarta:20240301T090000\n" +
+		"RRULE:FREQ=DAILY;COUNT=3\n" +
+		"RDATE:20240310T020000Z\n" +
+		"EXDATE:20240312T030000Z")
+	if err != nil {
+		t.Fatalf("failed to parse set: %v", err)
+	}
+
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	got, err := set.InLocation(berlin, SameWallClock)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := "DTSTART;TZID=Europe/Berlin:20240301T090000\n" +
+		"RRULE:FREQ=DAILY;COUNT=3\n" +
+		"RDATE;TZID=Europe/Berlin:20240310T090000\n" +
+		"EXDATE;TZID=Europe/Berlin:20240312T100000"
+	if got.String() != expected {
+		t.Errorf("expected %q, got %q", expected, got.String())
+	}
+}